@@ -0,0 +1,198 @@
+// Package algo wraps the graph analytics procedures of FalkorDB.
+//
+// Every function calls its read only procedure through Graph.Call and decodes
+// the yielded rows into a map keyed by node ID, holding either a score or the
+// identifier of the community the node was assigned to.
+// Options left to their zero value fall back to the procedure's defaults.
+package algo
+
+import (
+	"fmt"
+
+	"github.com/FalkorDB/falkordb-go/v2"
+	"github.com/FalkorDB/falkordb-go/v2/internal/numeric"
+)
+
+// PageRankOptions restrict the nodes and edges PageRank runs on.
+type PageRankOptions struct {
+	// Label restricts the nodes ranked, all when empty.
+	Label string
+	// RelType restricts the relationships followed, all when empty.
+	RelType string
+}
+
+// WCCOptions restrict the nodes and edges WCC runs on.
+type WCCOptions struct {
+	// NodeLabels restricts the nodes considered, all when empty.
+	NodeLabels []string
+	// RelTypes restricts the relationships followed, all when empty.
+	RelTypes []string
+}
+
+// BetweennessOptions control the computation of Betweenness.
+type BetweennessOptions struct {
+	// NodeLabels restricts the nodes considered, all when empty.
+	NodeLabels []string
+	// RelTypes restricts the relationships followed, all when empty.
+	RelTypes []string
+	// SamplingSize is the number of source nodes sampled to approximate
+	// the centrality, the procedure's default when unset.
+	SamplingSize int
+	// SamplingSeed seeds the sampling of source nodes, random when unset.
+	SamplingSeed int64
+}
+
+// LabelPropagationOptions control the computation of LabelPropagation.
+type LabelPropagationOptions struct {
+	// NodeLabels restricts the nodes considered, all when empty.
+	NodeLabels []string
+	// RelTypes restricts the relationships followed, all when empty.
+	RelTypes []string
+	// MaxIterations caps the number of iterations, the procedure's default when unset.
+	MaxIterations int
+}
+
+// caller calls procedures, as implemented by *falkordb.Graph.
+type caller interface {
+	Call(call falkordb.ProcedureCall) (*falkordb.QueryResult, error)
+}
+
+// call invokes the read only procedure name with args, yielding yield.
+func call(c caller, name string, yield []string, args ...interface{}) (*falkordb.QueryResult, error) {
+	return c.Call(falkordb.ProcedureCall{Name: name, Args: args, Yield: yield, ReadOnly: true})
+}
+
+// PageRank computes the PageRank score of every node using algo.pageRank.
+func PageRank(g *falkordb.Graph, options PageRankOptions) (map[uint64]float64, error) {
+	return pageRank(g, options)
+}
+
+// WCC assigns every node the identifier of its weakly connected component
+// using algo.WCC.
+func WCC(g *falkordb.Graph, options WCCOptions) (map[uint64]int64, error) {
+	return wcc(g, options)
+}
+
+// Betweenness computes the betweenness centrality of every node using
+// algo.betweenness.
+func Betweenness(g *falkordb.Graph, options BetweennessOptions) (map[uint64]float64, error) {
+	return betweenness(g, options)
+}
+
+// LabelPropagation assigns every node the identifier of its community,
+// as detected by label propagation using algo.labelPropagation.
+func LabelPropagation(g *falkordb.Graph, options LabelPropagationOptions) (map[uint64]int64, error) {
+	return labelPropagation(g, options)
+}
+
+func pageRank(c caller, options PageRankOptions) (map[uint64]float64, error) {
+	qr, err := call(c, "algo.pageRank", []string{"node", "score"},
+		optionalString(options.Label), optionalString(options.RelType))
+	if err != nil {
+		return nil, err
+	}
+	return scores(qr)
+}
+
+func wcc(c caller, options WCCOptions) (map[uint64]int64, error) {
+	config := scopeConfig(options.NodeLabels, options.RelTypes)
+	qr, err := call(c, "algo.WCC", []string{"node", "componentId"}, config)
+	if err != nil {
+		return nil, err
+	}
+	return communities(qr)
+}
+
+func betweenness(c caller, options BetweennessOptions) (map[uint64]float64, error) {
+	config := scopeConfig(options.NodeLabels, options.RelTypes)
+	if options.SamplingSize > 0 {
+		config["samplingSize"] = options.SamplingSize
+	}
+	if options.SamplingSeed != 0 {
+		config["samplingSeed"] = options.SamplingSeed
+	}
+	qr, err := call(c, "algo.betweenness", []string{"node", "score"}, config)
+	if err != nil {
+		return nil, err
+	}
+	return scores(qr)
+}
+
+func labelPropagation(c caller, options LabelPropagationOptions) (map[uint64]int64, error) {
+	config := scopeConfig(options.NodeLabels, options.RelTypes)
+	if options.MaxIterations > 0 {
+		config["maxIterations"] = options.MaxIterations
+	}
+	qr, err := call(c, "algo.labelPropagation", []string{"node", "communityId"}, config)
+	if err != nil {
+		return nil, err
+	}
+	return communities(qr)
+}
+
+// optionalString passes s as a procedure argument, null when empty.
+func optionalString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// scopeConfig builds a procedure configuration restricted to the nodes
+// labeled by one of labels and the relationships of one of relTypes.
+func scopeConfig(labels []string, relTypes []string) map[string]interface{} {
+	config := make(map[string]interface{})
+	if len(labels) > 0 {
+		config["nodeLabels"] = labels
+	}
+	if len(relTypes) > 0 {
+		config["relationshipTypes"] = relTypes
+	}
+	return config
+}
+
+// rows calls fn with the node ID and the value of every row of qr,
+// holding a node followed by a value.
+func rows(qr *falkordb.QueryResult, fn func(id uint64, v interface{}) error) error {
+	for qr.Next() {
+		values := qr.Record().Values()
+		if len(values) != 2 {
+			return fmt.Errorf("%w: expected a node and a value, got %d values", falkordb.ErrMalformedReply, len(values))
+		}
+		n, ok := values[0].(*falkordb.Node)
+		if !ok {
+			return fmt.Errorf("%w: expected a node, got %T", falkordb.ErrMalformedReply, values[0])
+		}
+		err := fn(n.ID, values[1])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scores(qr *falkordb.QueryResult) (map[uint64]float64, error) {
+	out := make(map[uint64]float64)
+	err := rows(qr, func(id uint64, v interface{}) error {
+		s, ok := numeric.Float64(v)
+		if !ok {
+			return fmt.Errorf("%w: expected a score, got %T", falkordb.ErrMalformedReply, v)
+		}
+		out[id] = s
+		return nil
+	})
+	return out, err
+}
+
+func communities(qr *falkordb.QueryResult) (map[uint64]int64, error) {
+	out := make(map[uint64]int64)
+	err := rows(qr, func(id uint64, v interface{}) error {
+		c, ok := v.(int64)
+		if !ok {
+			return fmt.Errorf("%w: expected a community identifier, got %T", falkordb.ErrMalformedReply, v)
+		}
+		out[id] = c
+		return nil
+	})
+	return out, err
+}
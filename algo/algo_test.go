@@ -0,0 +1,83 @@
+package algo
+
+import (
+	"testing"
+
+	"github.com/FalkorDB/falkordb-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCaller records calls and replies with rows of a node and a value.
+type fakeCaller struct {
+	calls  []falkordb.ProcedureCall
+	values []interface{}
+}
+
+func (f *fakeCaller) Call(call falkordb.ProcedureCall) (*falkordb.QueryResult, error) {
+	f.calls = append(f.calls, call)
+	yield := call.Yield
+
+	var rows []interface{}
+	for i, v := range f.values {
+		node := []interface{}{int64(falkordb.VALUE_NODE), []interface{}{int64(i), []interface{}{}, []interface{}{}}}
+		rows = append(rows, []interface{}{node, v})
+	}
+	reply := []interface{}{
+		[]interface{}{
+			[]interface{}{int64(falkordb.COLUMN_SCALAR), yield[0]},
+			[]interface{}{int64(falkordb.COLUMN_SCALAR), yield[1]},
+		},
+		rows,
+		[]interface{}{"Query internal execution time: 0.5 milliseconds"},
+	}
+	return falkordb.NewDecoder(falkordb.StaticSchema{}).Decode(reply)
+}
+
+func double(s string) interface{} {
+	return []interface{}{int64(falkordb.VALUE_DOUBLE), s}
+}
+
+func integer(i int64) interface{} {
+	return []interface{}{int64(falkordb.VALUE_INTEGER), i}
+}
+
+func TestScores(t *testing.T) {
+	f := &fakeCaller{values: []interface{}{double("0.25"), integer(1)}}
+	ranks, err := pageRank(f, PageRankOptions{Label: "Page"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64]float64{0: 0.25, 1: 1}, ranks)
+	assert.Equal(t, falkordb.ProcedureCall{Name: "algo.pageRank", Args: []interface{}{"Page", nil},
+		Yield: []string{"node", "score"}, ReadOnly: true}, f.calls[0])
+
+	_, err = betweenness(f, BetweennessOptions{RelTypes: []string{"KNOWS"}, SamplingSize: 10, SamplingSeed: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, "algo.betweenness", f.calls[1].Name)
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"relationshipTypes": []string{"KNOWS"},
+		"samplingSize":      10,
+		"samplingSeed":      int64(7),
+	}}, f.calls[1].Args)
+
+	f.values = []interface{}{integer(1), []interface{}{int64(falkordb.VALUE_STRING), "x"}}
+	_, err = pageRank(f, PageRankOptions{})
+	assert.ErrorIs(t, err, falkordb.ErrMalformedReply)
+}
+
+func TestCommunities(t *testing.T) {
+	f := &fakeCaller{values: []interface{}{integer(3), integer(3), integer(5)}}
+	components, err := wcc(f, WCCOptions{NodeLabels: []string{"User"}})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint64]int64{0: 3, 1: 3, 2: 5}, components)
+	assert.Equal(t, falkordb.ProcedureCall{Name: "algo.WCC",
+		Args:  []interface{}{map[string]interface{}{"nodeLabels": []string{"User"}}},
+		Yield: []string{"node", "componentId"}, ReadOnly: true}, f.calls[0])
+
+	_, err = labelPropagation(f, LabelPropagationOptions{MaxIterations: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"node", "communityId"}, f.calls[1].Yield)
+	assert.Equal(t, []interface{}{map[string]interface{}{"maxIterations": 5}}, f.calls[1].Args)
+
+	f.values = []interface{}{double("0.5")}
+	_, err = wcc(f, WCCOptions{})
+	assert.ErrorIs(t, err, falkordb.ErrMalformedReply)
+}
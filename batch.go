@@ -0,0 +1,218 @@
+package falkordb
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	defaultBatchSize     = 1000
+	defaultMaxBatchBytes = 4 << 20
+)
+
+// BulkOptions control how the rows of bulk operations are split into queries.
+type BulkOptions struct {
+	batchSize     int
+	maxBatchBytes int
+}
+
+// NewBulkOptions instantiates a new BulkOptions struct.
+func NewBulkOptions() *BulkOptions {
+	return &BulkOptions{
+		batchSize:     defaultBatchSize,
+		maxBatchBytes: defaultMaxBatchBytes,
+	}
+}
+
+// SetBatchSize sets the maximum number of rows sent in a single query.
+func (options *BulkOptions) SetBatchSize(batchSize int) *BulkOptions {
+	options.batchSize = batchSize
+	return options
+}
+
+// GetBatchSize retrieves the maximum number of rows sent in a single query.
+func (options *BulkOptions) GetBatchSize() int {
+	return options.batchSize
+}
+
+// SetMaxBatchBytes sets the maximum encoded size of the rows sent in a single query.
+// A row larger than the limit is sent on its own.
+func (options *BulkOptions) SetMaxBatchBytes(maxBatchBytes int) *BulkOptions {
+	options.maxBatchBytes = maxBatchBytes
+	return options
+}
+
+// GetMaxBatchBytes retrieves the maximum encoded size of the rows sent in a single query.
+func (options *BulkOptions) GetMaxBatchBytes() int {
+	return options.maxBatchBytes
+}
+
+// EdgeEndpoint identifies the nodes connected by bulk created edges.
+// Nodes are matched on their Key property, or on their ID if Key is empty,
+// and are restricted to Label unless it is empty.
+type EdgeEndpoint struct {
+	Label string
+	Key   string
+}
+
+// BulkEdge is a single edge of a bulk operation.
+// Source and Destination hold the Key property value, or the ID,
+// of the nodes the edge connects.
+type BulkEdge struct {
+	Source      interface{}
+	Destination interface{}
+	Properties  map[string]interface{}
+}
+
+// BulkCreateNodes creates a node labeled label for every row, the row being its properties.
+func (g *Graph) BulkCreateNodes(label string, rows []map[string]interface{}, options *BulkOptions) (Stats, error) {
+	q := fmt.Sprintf("UNWIND $rows AS row CREATE (n:%s) SET n = row", quoteIdentifier(label))
+	return g.unwind(q, nodeRows(rows), options)
+}
+
+// BulkMergeNodes upserts a node labeled label for every row, matching
+// existing nodes on the properties named by keys and adding the row's properties to them.
+func (g *Graph) BulkMergeNodes(label string, keys []string, rows []map[string]interface{}, options *BulkOptions) (Stats, error) {
+	if len(keys) == 0 {
+		return Stats{}, fmt.Errorf("merging nodes requires at least one key")
+	}
+	q := fmt.Sprintf("UNWIND $rows AS row MERGE (n:%s %s) SET n += row", quoteIdentifier(label), keyPattern(keys, "row"))
+	return g.unwind(q, nodeRows(rows), options)
+}
+
+// BulkCreateEdges creates an edge of type relation for every row,
+// connecting the nodes identified by src and dst.
+// Rows whose endpoints are not found are skipped.
+func (g *Graph) BulkCreateEdges(relation string, src EdgeEndpoint, dst EdgeEndpoint, rows []BulkEdge, options *BulkOptions) (Stats, error) {
+	q := fmt.Sprintf("UNWIND $rows AS row %s %s CREATE (s)-[e:%s]->(d) SET e = row.props",
+		endpointMatch("s", "row.src", src), endpointMatch("d", "row.dst", dst), quoteIdentifier(relation))
+	return g.unwind(q, edgeRows(rows), options)
+}
+
+// BulkMergeEdges upserts an edge of type relation for every row,
+// connecting the nodes identified by src and dst. Existing edges are matched
+// on the properties named by keys, or on their endpoints alone if keys is empty.
+func (g *Graph) BulkMergeEdges(relation string, src EdgeEndpoint, dst EdgeEndpoint, keys []string, rows []BulkEdge, options *BulkOptions) (Stats, error) {
+	pattern := ""
+	if len(keys) > 0 {
+		pattern = " " + keyPattern(keys, "row.props")
+	}
+	q := fmt.Sprintf("UNWIND $rows AS row %s %s MERGE (s)-[e:%s%s]->(d) SET e += row.props",
+		endpointMatch("s", "row.src", src), endpointMatch("d", "row.dst", dst), quoteIdentifier(relation), pattern)
+	return g.unwind(q, edgeRows(rows), options)
+}
+
+// keyPattern builds the {key: row.key, ...} map matching the keys of a row.
+func keyPattern(keys []string, row string) string {
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s: %s.%s", quoteIdentifier(k), row, quoteIdentifier(k))
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+func endpointMatch(alias string, value string, endpoint EdgeEndpoint) string {
+	label := ""
+	if endpoint.Label != "" {
+		label = ":" + quoteIdentifier(endpoint.Label)
+	}
+	if endpoint.Key == "" {
+		return fmt.Sprintf("MATCH (%s%s) WHERE ID(%s) = %s", alias, label, alias, value)
+	}
+	return fmt.Sprintf("MATCH (%s%s {%s: %s})", alias, label, quoteIdentifier(endpoint.Key), value)
+}
+
+func nodeRows(rows []map[string]interface{}) []interface{} {
+	out := make([]interface{}, len(rows))
+	for i, r := range rows {
+		if r == nil {
+			r = map[string]interface{}{}
+		}
+		out[i] = r
+	}
+	return out
+}
+
+func edgeRows(rows []BulkEdge) []interface{} {
+	out := make([]interface{}, len(rows))
+	for i, r := range rows {
+		props := r.Properties
+		if props == nil {
+			props = map[string]interface{}{}
+		}
+		out[i] = map[string]interface{}{
+			"src":   r.Source,
+			"dst":   r.Destination,
+			"props": props,
+		}
+	}
+	return out
+}
+
+// unwind runs query once per batch of rows, passing the batch as the $rows parameter,
+// and returns the aggregated statistics of the executed batches.
+func (g *Graph) unwind(query string, rows []interface{}, options *BulkOptions) (Stats, error) {
+	return g.unwindEach(query, rows, options, nil)
+}
+
+// unwindEach is unwind calling each, unless nil, with the result of every batch.
+func (g *Graph) unwindEach(query string, rows []interface{}, options *BulkOptions, each func(*QueryResult) error) (Stats, error) {
+	if options == nil {
+		options = NewBulkOptions()
+	}
+
+	batches, err := splitBatches(rows, options)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	var stats Stats
+	for _, batch := range batches {
+		res, err := g.Query("CYPHER rows=["+strings.Join(batch.rows, ",")+"] "+query, nil, nil)
+		if err == nil && each != nil {
+			err = each(res)
+		}
+		if err != nil {
+			return stats, fmt.Errorf("rows %d-%d: %w", batch.offset, batch.offset+len(batch.rows)-1, err)
+		}
+		stats = stats.Add(res.Stats())
+	}
+	return stats, nil
+}
+
+// batch is a run of encoded rows starting at row offset.
+type batch struct {
+	offset int
+	rows   []string
+}
+
+// splitBatches encodes rows and splits them into batches bounded by
+// the options' row count and encoded size.
+// Rows holding values which can't be encoded are reported before any is sent.
+func splitBatches(rows []interface{}, options *BulkOptions) ([]batch, error) {
+	var batches []batch
+	var current batch
+	size := 0
+	for i, row := range rows {
+		encoded, err := toStringErr(row)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
+		}
+		if len(current.rows) > 0 &&
+			((options.batchSize > 0 && len(current.rows) >= options.batchSize) ||
+				(options.maxBatchBytes > 0 && size+len(encoded) > options.maxBatchBytes)) {
+			batches = append(batches, current)
+			current = batch{}
+			size = 0
+		}
+		if len(current.rows) == 0 {
+			current.offset = i
+		}
+		current.rows = append(current.rows, encoded)
+		size += len(encoded) + 1
+	}
+	if len(current.rows) > 0 {
+		batches = append(batches, current)
+	}
+	return batches, nil
+}
@@ -0,0 +1,62 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitBatches(t *testing.T) {
+	rows := []interface{}{int64(1), int64(22), int64(333), int64(4444), int64(5)}
+
+	batches, err := splitBatches(rows, NewBulkOptions().SetBatchSize(2))
+	assert.NoError(t, err)
+	assert.Equal(t, []batch{
+		{offset: 0, rows: []string{"1", "22"}},
+		{offset: 2, rows: []string{"333", "4444"}},
+		{offset: 4, rows: []string{"5"}},
+	}, batches)
+
+	// rows are separated by a comma, a row larger than the limit is sent on its own
+	batches, err = splitBatches(rows, NewBulkOptions().SetMaxBatchBytes(7))
+	assert.NoError(t, err)
+	assert.Equal(t, []batch{
+		{offset: 0, rows: []string{"1", "22"}},
+		{offset: 2, rows: []string{"333"}},
+		{offset: 3, rows: []string{"4444", "5"}},
+	}, batches)
+
+	batches, err = splitBatches(nil, NewBulkOptions())
+	assert.NoError(t, err)
+	assert.Empty(t, batches)
+}
+
+func TestSplitBatchesEncoding(t *testing.T) {
+	rows := nodeRows([]map[string]interface{}{
+		{"n": int32(7), "tags": []int{1, 2}},
+		{"scores": []map[string]interface{}{{"v": float32(0.5)}}, "id": uint64(3)},
+	})
+	batches, err := splitBatches(rows, NewBulkOptions())
+	assert.NoError(t, err)
+	assert.Len(t, batches[0].rows, 2)
+	assert.Contains(t, batches[0].rows[0], "n: 7")
+	assert.Contains(t, batches[0].rows[0], "tags: [1,2]")
+	assert.Contains(t, batches[0].rows[1], "scores: [{v: 0.5}]")
+
+	// values which can't be encoded are reported with their row
+	rows = nodeRows([]map[string]interface{}{{"a": 1}, {"b": struct{}{}}})
+	_, err = splitBatches(rows, NewBulkOptions())
+	assert.EqualError(t, err, `row 1: key "b": unsupported parameter type struct {}`)
+
+	// bulk operations fail before sending anything
+	g := &Graph{Id: "detached"}
+	_, err = g.BulkCreateNodes("Person", []map[string]interface{}{{"id": uint64(1 << 63)}}, nil)
+	assert.ErrorContains(t, err, "overflows")
+}
+
+func TestBulkQueryPatterns(t *testing.T) {
+	assert.Equal(t, "{`id`: row.`id`, `a b`: row.`a b`}", keyPattern([]string{"id", "a b"}, "row"))
+	assert.Equal(t, "MATCH (s:`Person` {`id`: row.src})", endpointMatch("s", "row.src", EdgeEndpoint{Label: "Person", Key: "id"}))
+	assert.Equal(t, "MATCH (d) WHERE ID(d) = row.dst", endpointMatch("d", "row.dst", EdgeEndpoint{}))
+	assert.Equal(t, "{`first name`: \"a\"}", ToString(map[string]interface{}{"first name": "a"}))
+}
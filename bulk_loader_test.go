@@ -0,0 +1,68 @@
+package falkordb
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkEncoding(t *testing.T) {
+	b := detachedGraph().BulkLoader(nil)
+	id, err := b.AddNode("Person", map[string]interface{}{"name": "a", "age": 3})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), id)
+	_, err = b.AddNode("Person", map[string]interface{}{"name": "b", "age": 2.5})
+	assert.NoError(t, err)
+	assert.NoError(t, b.AddEdge("Knows", 0, 1, nil))
+
+	// entities sharing a label and keys go into the same blob, properties sorted by key
+	assert.Len(t, b.labels, 1)
+	assert.Equal(t, []byte("Person\x00\x02\x00\x00\x00age\x00name\x00"+
+		"\x04\x03\x00\x00\x00\x00\x00\x00\x00\x03a\x00"+
+		"\x02\x00\x00\x00\x00\x00\x00\x04\x40\x03b\x00"), b.labels[0].buf.Bytes())
+	assert.Equal(t, []byte("Knows\x00\x00\x00\x00\x00"+
+		"\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00"), b.relations[0].buf.Bytes())
+
+	var buf bytes.Buffer
+	assert.NoError(t, encodeBulkValue(&buf, []interface{}{true, nil}))
+	assert.Equal(t, []byte("\x05\x02\x00\x00\x00\x00\x00\x00\x00\x01\x01\x00"), buf.Bytes())
+
+	// a different key set starts a new blob
+	_, err = b.AddNode("Person", map[string]interface{}{"name": "c"})
+	assert.NoError(t, err)
+	assert.Len(t, b.labels, 2)
+	assert.Equal(t, 3, b.pendingNodes)
+	assert.Equal(t, 1, b.pendingEdges)
+
+	assert.Error(t, b.AddEdge("Knows", 0, 3, nil))
+	_, err = b.AddNode("Person", map[string]interface{}{"x": struct{}{}})
+	assert.Error(t, err)
+	_, err = b.AddNode("Person", map[string]interface{}{"x": "a\x00b"})
+	assert.Error(t, err)
+	_, err = b.AddNode("", nil)
+	assert.Error(t, err)
+
+	b = detachedGraph().BulkLoader(NewBulkLoaderOptions().SetMaxTokenSize(8))
+	_, err = b.AddNode("Person", map[string]interface{}{"name": "a"})
+	assert.Error(t, err)
+}
+
+func TestBulkCSV(t *testing.T) {
+	b := detachedGraph().BulkLoader(nil)
+	err := b.LoadNodesCSV("Person", strings.NewReader("_key,name,age\nk1,a,3\nk2,b,\n"))
+	assert.NoError(t, err)
+	id, ok := b.NodeID("k2")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), id)
+
+	err = b.LoadEdgesCSV("Knows", strings.NewReader("src,dst,since\nk1,k2,2020\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, b.pendingEdges)
+
+	err = b.LoadEdgesCSV("Knows", strings.NewReader("src,dst\nk1,k3\n"))
+	assert.ErrorContains(t, err, "line 2")
+	err = b.LoadNodesCSV("Person", strings.NewReader("_key\nk1\n"))
+	assert.ErrorContains(t, err, "duplicate")
+}
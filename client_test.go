@@ -1,9 +1,12 @@
 package falkordb
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -128,6 +131,419 @@ func TestCreateQuery(t *testing.T) {
 	assert.Equal(t, wNode.Labels[0], "WorkPlace", "Unexpected node label.")
 }
 
+func TestColumns(t *testing.T) {
+	createGraph()
+
+	res, err := graph.Query("MATCH (p:Person) WHERE p.age > 1000 RETURN p, p.name AS name", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, res.Empty(), "Expecting empty result-set")
+	columns := res.Columns()
+	assert.Equal(t, 2, len(columns), "Expecting 2 columns")
+	assert.Equal(t, "p", columns[0].Name)
+	assert.Equal(t, "name", columns[1].Name)
+	assert.Equal(t, VALUE_UNKNOWN, columns[1].ScalarType)
+
+	res, err = graph.Query("UNWIND [1, null, 2] AS x RETURN x, null AS n, CASE WHEN x = 1 THEN 'a' ELSE x END AS m", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	columns = res.Columns()
+	assert.Equal(t, COLUMN_SCALAR, columns[0].Type)
+	assert.Equal(t, VALUE_INTEGER, columns[0].ScalarType)
+	assert.Equal(t, VALUE_NULL, columns[1].ScalarType)
+	assert.Equal(t, VALUE_UNKNOWN, columns[2].ScalarType)
+
+	res, err = graph.Query("MATCH (p:Person)-[v]->() RETURN p, v", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	columns = res.Columns()
+	assert.Equal(t, VALUE_NODE, columns[0].ScalarType)
+	assert.Equal(t, VALUE_EDGE, columns[1].ScalarType)
+}
+
+func TestTypedQueries(t *testing.T) {
+	createGraph()
+
+	name, err := QueryScalar[string](graph, "MATCH (p:Person) RETURN p.name", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", name)
+
+	_, err = QueryScalar[string](graph, "MATCH (p:Person) WHERE p.age > 100 RETURN p.name", nil, nil)
+	assert.ErrorIs(t, err, ErrNoRows)
+
+	_, err = QueryScalar[int](graph, "UNWIND [1, 2] AS x RETURN x", nil, nil)
+	assert.ErrorIs(t, err, ErrMultipleRows)
+
+	xs, err := QueryColumn[int](graph, "UNWIND range(1, 3) AS x RETURN x", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, xs)
+
+	type visit struct {
+		Person  string `falkordb:"person"`
+		Country string
+		Year    int
+	}
+	visits, err := QueryStructs[visit](graph, "MATCH (p:Person)-[v:Visited]->(c:Country) RETURN p.name AS person, c.name AS country, v.year AS year", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []visit{{Person: "John Doe", Country: "Japan", Year: 2017}}, visits)
+
+	type country struct {
+		Name       string
+		Population int64
+	}
+	c, err := QueryOne[country](graph, "MATCH (c:Country) RETURN c", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, country{Name: "Japan", Population: 126800000}, c)
+
+	options := NewQueryOptions().SetTimeout(1000)
+	name, err = ROQueryScalar[string](graph, "MATCH (p:Person) RETURN p.name", nil, options)
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", name)
+	c, err = ROQueryOne[country](graph, "MATCH (c:Country) RETURN c", nil, options)
+	assert.NoError(t, err)
+	assert.Equal(t, "Japan", c.Name)
+	xs, err = ROQueryColumn[int](graph, "UNWIND range(1, 2) AS x RETURN x", nil, options)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, xs)
+	visits, err = ROQueryStructs[visit](graph, "MATCH (p:Person)-[v:Visited]->(c:Country) RETURN p.name AS person, c.name AS country, v.year AS year", nil, options)
+	assert.NoError(t, err)
+	assert.Len(t, visits, 1)
+	_, err = ROQueryScalar[int](graph, "CREATE () RETURN 1", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestPipeline(t *testing.T) {
+	createGraph()
+
+	p := graph.Pipeline()
+	p.Query("CREATE (:Batch {i: $i})", map[string]interface{}{"i": 1}, nil)
+	p.Query("CREATE (:Batch {i: 2})-[:NEXT]->(:Batch {i: 3})", nil, nil)
+	p.ROQuery("MATCH (b:Batch)-[e:NEXT]->() RETURN b, e", nil, nil)
+	assert.Equal(t, 3, p.Len())
+
+	results, err := p.Exec()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, 0, p.Len(), "Expecting the pipeline to be emptied")
+	assert.Equal(t, 1, results[0].NodesCreated())
+	assert.Equal(t, 2, results[1].NodesCreated())
+
+	results[2].Next()
+	n, err := results[2].Record().GetByIndex(0)
+	assert.NoError(t, err)
+	assert.Equal(t, "Batch", n.(*Node).Labels[0])
+	e, err := results[2].Record().GetByIndex(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "NEXT", e.(*Edge).Relation)
+
+	p.Query("RETURN 1", nil, nil)
+	p.ROQuery("CREATE ()", nil, nil)
+	results, err = p.Exec()
+	var pipelineErr *PipelineError
+	assert.ErrorAs(t, err, &pipelineErr)
+	assert.Nil(t, pipelineErr.Errors[0])
+	assert.Error(t, pipelineErr.Errors[1])
+	assert.NotNil(t, results[0])
+	assert.Nil(t, results[1])
+}
+
+func TestBulkUpsert(t *testing.T) {
+	createGraph()
+
+	people := make([]map[string]interface{}, 25)
+	for i := range people {
+		people[i] = map[string]interface{}{"id": i, "name": fmt.Sprintf("p%d", i)}
+	}
+	options := NewBulkOptions().SetBatchSize(10)
+
+	stats, err := graph.BulkCreateNodes("Member", people, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 25, stats.NodesCreated)
+	assert.Equal(t, 50, stats.PropertiesSet)
+
+	stats, err = graph.BulkMergeNodes("Member", []string{"id"}, []map[string]interface{}{
+		{"id": 0, "name": "renamed"},
+		{"id": 100, "name": "new"},
+	}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stats.NodesCreated)
+
+	edges := make([]BulkEdge, 24)
+	for i := range edges {
+		edges[i] = BulkEdge{Source: i, Destination: i + 1, Properties: map[string]interface{}{"w": i}}
+	}
+	endpoint := EdgeEndpoint{Label: "Member", Key: "id"}
+	stats, err = graph.BulkCreateEdges("FOLLOWS", endpoint, endpoint, edges, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 24, stats.RelationshipsCreated)
+
+	stats, err = graph.BulkMergeEdges("FOLLOWS", endpoint, endpoint, nil, edges[:2], options)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, stats.RelationshipsCreated)
+
+	name, err := QueryScalar[string](graph, "MATCH (m:Member {id: 0}) RETURN m.name", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "renamed", name)
+}
+
+func TestBulkLoader(t *testing.T) {
+	db, _ := FromURL("falkor://0.0.0.0:6379")
+	defer db.Conn.Close()
+	g := db.SelectGraph("bulk")
+	g.Delete()
+	defer g.Delete()
+
+	// small limits force the load to span several commands
+	loader := g.BulkLoader(NewBulkLoaderOptions().SetMaxTokenCount(2).SetMaxBufferSize(256))
+	err := loader.LoadNodesCSV("Person", strings.NewReader("name,age,tags\nalice,33,\"['a', 'b']\"\nbob,,[]\n"))
+	assert.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		_, err = loader.AddNode("Country", map[string]interface{}{"code": i, "score": 0.5})
+		assert.NoError(t, err)
+	}
+	err = loader.LoadEdgesCSV("Knows", strings.NewReader("src,dst,since\nalice,bob,2020\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, loader.AddEdge("Visited", 0, 2, map[string]interface{}{"ok": true}))
+
+	stats, err := loader.Commit()
+	assert.NoError(t, err)
+	assert.Equal(t, 22, stats.NodesCreated)
+	assert.Equal(t, 2, stats.RelationshipsCreated)
+
+	age, err := QueryScalar[int](g, "MATCH (:Person {name: 'bob'})<-[:Knows {since: 2020}]-(p) RETURN p.age", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 33, age)
+	tags, err := QueryScalar[[]string](g, "MATCH (p:Person {name: 'alice'}) RETURN p.tags", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, tags)
+	count, err := QueryScalar[int](g, "MATCH (c:Country) WHERE c.score = 0.5 RETURN count(c)", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, count)
+}
+
+func TestExportImport(t *testing.T) {
+	createGraph()
+	_, err := graph.Query("MATCH (p:Person) SET p.score = 2.0, p.home = point({latitude: 1.5, longitude: 2.5}), p.born = date('1990-05-01')", nil, nil)
+	assert.NoError(t, err)
+
+	db, _ := FromURL("falkor://0.0.0.0:6379")
+	defer db.Conn.Close()
+	for _, format := range []ExportFormat{EXPORT_JSON, EXPORT_NDJSON} {
+		var buf strings.Builder
+		err = graph.Export(&buf, format)
+		assert.NoError(t, err)
+
+		copied := db.SelectGraph("social_copy")
+		copied.Delete()
+		stats, err := copied.Import(strings.NewReader(buf.String()), format)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, stats.NodesCreated)
+		assert.Equal(t, 1, stats.RelationshipsCreated)
+
+		res, err := copied.ROQuery("MATCH (s)-[e]->(d) RETURN s,e,d", nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res.results), "expecting 1 result record")
+		res.Next()
+		s, _ := res.Record().GetByIndex(0)
+		e, _ := res.Record().GetByIndex(1)
+		d, _ := res.Record().GetByIndex(2)
+		assert.Equal(t, []string{"Person"}, s.(*Node).Labels)
+		assert.Equal(t, int64(33), s.(*Node).GetProperty("age"))
+		assert.Equal(t, 2.0, s.(*Node).GetProperty("score"))
+		assert.Equal(t, Point{Latitude: 1.5, Longitude: 2.5}, s.(*Node).GetProperty("home"))
+		assert.Equal(t, DateOf(time.Date(1990, time.May, 1, 0, 0, 0, 0, time.UTC)), s.(*Node).GetProperty("born"))
+		assert.Equal(t, "Visited", e.(*Edge).Relation)
+		assert.Equal(t, int64(2017), e.(*Edge).GetProperty("year"))
+		assert.Equal(t, "Japan", d.(*Node).GetProperty("name"))
+		copied.Delete()
+	}
+}
+
+func TestExportSparseIDs(t *testing.T) {
+	db, _ := FromURL("falkor://0.0.0.0:6379")
+	defer db.Conn.Close()
+	g := db.SelectGraph("sparse")
+	g.Delete()
+	defer g.Delete()
+
+	// leave a gap of several pages between the first and last nodes
+	_, err := g.Query("UNWIND range(0, 4999) AS i CREATE (:N {i: i})", nil, nil)
+	assert.NoError(t, err)
+	_, err = g.Query("MATCH (a:N {i: 0}), (b:N {i: 4999}) CREATE (a)-[:R]->(b), (b)-[:R]->(a)", nil, nil)
+	assert.NoError(t, err)
+	_, err = g.Query("MATCH (n:N) WHERE n.i > 0 AND n.i < 4999 DELETE n", nil, nil)
+	assert.NoError(t, err)
+
+	var nodes, edges int
+	assert.NoError(t, g.scanNodes(func(*Node) error { nodes++; return nil }))
+	assert.NoError(t, g.scanEdges(func(*Edge) error { edges++; return nil }))
+	assert.Equal(t, 2, nodes)
+	assert.Equal(t, 2, edges)
+}
+
+func TestExchangeFormats(t *testing.T) {
+	createGraph()
+
+	var buf strings.Builder
+	err := graph.Export(&buf, EXPORT_GRAPHML)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `<key id="d0" for="node" attr.name="age" attr.type="long"></key>`)
+	assert.Contains(t, buf.String(), `<data key="relation">Visited</data>`)
+
+	res, err := graph.Query("MATCH p = (:Person)-[:Visited]->() RETURN p", nil, nil)
+	assert.NoError(t, err)
+	buf.Reset()
+	err = res.Export(&buf, EXPORT_GEXF)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `<node id="0" label="Person">`)
+	assert.Contains(t, buf.String(), `label="Visited"`)
+}
+
+func TestResolveEndpoints(t *testing.T) {
+	createGraph()
+
+	res, err := graph.ROQuery("MATCH ()-[e]->() RETURN e", nil, nil)
+	assert.NoError(t, err)
+	res.Next()
+	v, _ := res.Record().GetByIndex(0)
+	e := v.(*Edge)
+	assert.Nil(t, e.Source)
+	assert.NoError(t, e.ResolveEndpoints(context.Background()))
+	assert.Equal(t, "John Doe", e.Source.GetProperty("name"))
+	assert.Equal(t, "Japan", e.Destination.GetProperty("name"))
+
+	options := NewQueryOptions().SetResolveEndpoints(true)
+	res, err = graph.ROQuery("MATCH p = ()-[e]->() RETURN e, p", nil, options)
+	assert.NoError(t, err)
+	res.Next()
+	v, _ = res.Record().GetByIndex(0)
+	assert.Equal(t, []string{"Person"}, v.(*Edge).Source.Labels)
+	p, _ := res.Record().GetByIndex(1)
+	assert.Equal(t, "Japan", p.(Path).Edges[0].Destination.GetProperty("name"))
+
+	nodes, err := graph.GetNodesByID([]uint64{e.SourceNodeID(), 1000})
+	assert.NoError(t, err)
+	assert.Len(t, nodes, 1)
+	assert.Equal(t, "John Doe", nodes[e.SourceNodeID()].GetProperty("name"))
+}
+
+func TestEntitiesByID(t *testing.T) {
+	createGraph()
+
+	res, err := graph.ROQuery("MATCH (s)-[e]->(d) RETURN ID(s), ID(e), ID(d)", nil, nil)
+	assert.NoError(t, err)
+	res.Next()
+	r := res.Record()
+	s, _ := r.GetByIndex(0)
+	e, _ := r.GetByIndex(1)
+	d, _ := r.GetByIndex(2)
+	src, edge, dst := uint64(s.(int64)), uint64(e.(int64)), uint64(d.(int64))
+
+	n, err := graph.GetNode(src)
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", n.GetProperty("name"))
+
+	nodes, err := graph.GetNodes([]uint64{dst, 1000, src})
+	assert.NoError(t, err)
+	assert.Equal(t, "Japan", nodes[0].GetProperty("name"))
+	assert.Nil(t, nodes[1])
+	assert.Equal(t, src, nodes[2].ID)
+
+	ed, err := graph.GetEdge(edge)
+	assert.NoError(t, err)
+	assert.Equal(t, "Visited", ed.Relation)
+	assert.Equal(t, dst, ed.DestNodeID())
+
+	_, err = graph.GetNode(1000)
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+	_, err = graph.GetEdge(1000)
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+
+	assert.NoError(t, graph.DeleteEdge(edge))
+	assert.ErrorIs(t, graph.DeleteEdge(edge), ErrEntityNotFound)
+	assert.NoError(t, graph.DeleteNode(src))
+	assert.ErrorIs(t, graph.DeleteNode(src), ErrEntityNotFound)
+}
+
+func TestNeighbors(t *testing.T) {
+	createGraph()
+	_, err := graph.Query("MATCH (c:Country) CREATE (c)-[:In]->(:Continent {name: 'Asia'})", nil, nil)
+	assert.NoError(t, err)
+	john, err := QueryScalar[int64](graph, "MATCH (p:Person) RETURN ID(p)", nil, nil)
+	assert.NoError(t, err)
+
+	nb, err := graph.Neighbors(uint64(john), NeighborOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, nb.Nodes, 1)
+	assert.Equal(t, "Japan", nb.Nodes[0].GetProperty("name"))
+	assert.Len(t, nb.Edges, 1)
+	assert.Len(t, nb.Paths, 1)
+
+	nb, err = graph.Neighbors(uint64(john), NeighborOptions{MaxDepth: 2, LabelFilter: []string{"Continent"}})
+	assert.NoError(t, err)
+	assert.Len(t, nb.Nodes, 1)
+	assert.Equal(t, "Asia", nb.Nodes[0].GetProperty("name"))
+	assert.Len(t, nb.Edges, 2)
+	assert.Equal(t, 2, nb.Paths[0].EdgeCount())
+
+	nb, err = graph.Neighbors(uint64(john), NeighborOptions{RelTypes: []string{"In"}, Direction: DIRECTION_BOTH})
+	assert.NoError(t, err)
+	assert.Empty(t, nb.Nodes)
+}
+
+func TestPathProcedures(t *testing.T) {
+	createGraph()
+	_, err := graph.Query("MATCH (c:Country) CREATE (c)-[:Visited {year: 2020}]->(:Country {name: 'Korea'})", nil, nil)
+	assert.NoError(t, err)
+	john, err := QueryScalar[int64](graph, "MATCH (p:Person) RETURN ID(p)", nil, nil)
+	assert.NoError(t, err)
+	korea, err := QueryScalar[int64](graph, "MATCH (c:Country {name: 'Korea'}) RETURN ID(c)", nil, nil)
+	assert.NoError(t, err)
+
+	paths, err := graph.ShortestPaths(uint64(john), uint64(korea), PathOptions{RelTypes: []string{"Visited"}, WeightProperty: "year"})
+	assert.NoError(t, err)
+	assert.Len(t, paths, 1)
+	assert.Equal(t, 2, paths[0].Path.EdgeCount())
+	assert.Equal(t, 4037.0, paths[0].Weight)
+	assert.Equal(t, 2.0, paths[0].Cost)
+
+	paths, err = graph.SingleSourcePaths(uint64(john), PathOptions{MaxLength: 1})
+	assert.NoError(t, err)
+	assert.Len(t, paths, 1)
+
+	nodes, edges, err := graph.BFS(uint64(john), BFSOptions{RelType: "Visited"})
+	assert.NoError(t, err)
+	assert.Len(t, nodes, 2)
+	assert.Len(t, edges, 2)
+
+	_, _, err = graph.BFS(1000, BFSOptions{})
+	assert.ErrorIs(t, err, ErrEntityNotFound)
+}
+
+func TestCallProcedureArgs(t *testing.T) {
+	createGraph()
+
+	res, err := graph.CallProcedure("algo.pageRank", []string{"node", "score"}, "Country", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.results), "expecting only the Country node to be ranked")
+
+	res, err = graph.Call(ProcedureCall{Name: "db.labels", ReadOnly: true, Options: NewQueryOptions().SetTimeout(1000)})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.results), "expecting 2 labels")
+}
+
+func TestDeleteWithStats(t *testing.T) {
+	createGraph()
+
+	stats, err := graph.DeleteWithStats()
+	assert.NoError(t, err)
+	assert.Greater(t, stats.GraphRemovedInternalExecutionTime, 0.0)
+	createGraph()
+}
+
 func TestCreateROQueryFailure(t *testing.T) {
 	q := "CREATE (w:WorkPlace {name:'FalkorDB'})"
 	_, err := graph.ROQuery(q, nil, nil)
@@ -334,10 +750,40 @@ func TestPoint(t *testing.T) {
 	r := res.Record()
 	pointIface, err := r.GetByIndex(0)
 	assert.NoError(t, err)
-	point := pointIface.(map[string]interface{})
+	point, ok := pointIface.(Point)
+	assert.True(t, ok)
+
+	assert.Equal(t, point.Latitude, 37.0, "Unexpected latitude value")
+	assert.Equal(t, point.Longitude, -122.0, "Unexpected longitude value")
 
-	assert.Equal(t, point["latitude"], 37.0, "Unexpected latitude value")
-	assert.Equal(t, point["longitude"], -122.0, "Unexpected longitude value")
+	res, err = graph.Query("RETURN $p", map[string]interface{}{"p": Point{Latitude: 32.07, Longitude: 34.78}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Next()
+	pointIface, err = res.Record().GetByIndex(0)
+	assert.NoError(t, err)
+	assert.InDelta(t, 32.07, pointIface.(Point).Latitude, 1e-4, "Unexpected latitude value")
+	assert.InDelta(t, 34.78, pointIface.(Point).Longitude, 1e-4, "Unexpected longitude value")
+}
+
+func TestNodesWithinDistance(t *testing.T) {
+	createGraph()
+
+	_, err := graph.Query("CREATE (:Store {name: 'near', loc: point({latitude: 32.07, longitude: 34.78})}), (:Store {name: 'nearest', loc: point({latitude: 32.071, longitude: 34.781})}), (:Store {name: 'far', loc: point({latitude: 31.77, longitude: 35.21})})", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	center := Point{Latitude: 32.0711, Longitude: 34.7811}
+	nodes, err := graph.NodesWithinDistance("Store", "loc", center, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(nodes), "Expecting 2 stores within 1km")
+	assert.Equal(t, "nearest", nodes[0].GetProperty("name"))
+	assert.Equal(t, "near", nodes[1].GetProperty("name"))
+
+	far := Point{Latitude: 31.77, Longitude: 35.21}
+	assert.InDelta(t, 53000, center.Distance(far), 2000, "Unexpected distance")
 }
 
 func TestVectorF32(t *testing.T) {
@@ -353,6 +799,66 @@ func TestVectorF32(t *testing.T) {
 	vec, ok := vecIface.([]float32)
 	assert.True(t, ok)
 	assert.Equal(t, vec, []float32{1.0, 2.0, 3.0}, "Unexpected vector value")
+
+	params := []interface{}{[]float32{0.5, -1.25, 3}, Vector32{0.1, 0.2}}
+	for _, param := range params {
+		res, err = graph.Query("RETURN $vec", map[string]interface{}{"vec": param}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Next()
+		vecIface, err = res.Record().GetByIndex(0)
+		assert.NoError(t, err)
+		switch p := param.(type) {
+		case Vector32:
+			assert.Equal(t, []float32(p), vecIface, "Unexpected vector value")
+		default:
+			assert.Equal(t, p, vecIface, "Unexpected vector value")
+		}
+	}
+}
+
+func TestTemporal(t *testing.T) {
+	q := "RETURN localdatetime({year: 2024, month: 3, day: 15, hour: 10, minute: 20, second: 30}), date({year: 2024, month: 3, day: 15}), localtime({hour: 10, minute: 20, second: 30}), duration({hours: 1, minutes: 30})"
+	res, err := graph.Query(q, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Next()
+	r := res.Record()
+
+	dt, err := r.GetByIndex(0)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.March, 15, 10, 20, 30, 0, time.UTC), dt, "Unexpected datetime value")
+
+	d, err := r.GetByIndex(1)
+	assert.NoError(t, err)
+	assert.Equal(t, DateOf(time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)), d, "Unexpected date value")
+
+	lt, err := r.GetByIndex(2)
+	assert.NoError(t, err)
+	assert.Equal(t, "10:20:30", lt.(LocalTime).String(), "Unexpected time value")
+
+	dur, err := r.GetByIndex(3)
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, dur, "Unexpected duration value")
+
+	params := []interface{}{
+		time.Date(2021, time.July, 4, 8, 9, 10, 0, time.UTC),
+		DateOf(time.Date(2021, time.July, 4, 0, 0, 0, 0, time.UTC)),
+		LocalTimeOf(time.Date(1970, time.January, 1, 8, 9, 10, 0, time.UTC)),
+		36 * time.Hour,
+	}
+	for _, param := range params {
+		res, err := graph.Query("RETURN $param", map[string]interface{}{"param": param}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Next()
+		v, err := res.Record().GetByIndex(0)
+		assert.NoError(t, err)
+		assert.Equal(t, param, v, "Unexpected parameter value")
+	}
 }
 
 func TestParameterizedQuery(t *testing.T) {
@@ -432,6 +938,21 @@ func TestQueryStatistics(t *testing.T) {
 	assert.Equal(t, 1, res.RelationshipsDeleted(), "Expecting 1 relationships deleted")
 }
 
+func TestRemovalStatistics(t *testing.T) {
+	createGraph()
+
+	res, err := graph.Query("MATCH (p:Person) REMOVE p:Person, p.status", nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, res.LabelsRemoved(), "Expecting 1 label removed")
+	assert.Equal(t, 1, res.PropertiesRemoved(), "Expecting 1 property removed")
+
+	stats := res.Stats()
+	assert.Equal(t, 1, stats.LabelsRemoved)
+	assert.Equal(t, 1, stats.PropertiesRemoved)
+	assert.Equal(t, res.InternalExecutionTime(), stats.InternalExecutionTime)
+	assert.Contains(t, res.Statistics(), LABELS_REMOVED)
+}
+
 func TestUtils(t *testing.T) {
 	res := RandomString(10)
 	assert.Equal(t, len(res), 10)
@@ -445,6 +966,9 @@ func TestUtils(t *testing.T) {
 	res = ToString(1.2)
 	assert.Equal(t, res, "1.2")
 
+	res = ToString(2.0)
+	assert.Equal(t, res, "2.0")
+
 	res = ToString(true)
 	assert.Equal(t, res, "true")
 
@@ -456,6 +980,27 @@ func TestUtils(t *testing.T) {
 	jsonMap["object"] = map[string]interface{}{"foo": 1}
 	res = ToString(jsonMap)
 	assert.Equal(t, res, "{object: {foo: 1}}")
+
+	res = ToString(time.Date(2024, time.March, 15, 10, 20, 30, 0, time.UTC))
+	assert.Equal(t, res, "localdatetime({year: 2024, month: 3, day: 15, hour: 10, minute: 20, second: 30})")
+
+	res = ToString(DateOf(time.Date(2024, time.March, 15, 10, 20, 30, 0, time.UTC)))
+	assert.Equal(t, res, "date({year: 2024, month: 3, day: 15})")
+
+	res = ToString(LocalTimeOf(time.Date(2024, time.March, 15, 10, 20, 30, 0, time.UTC)))
+	assert.Equal(t, res, "localtime({hour: 10, minute: 20, second: 30})")
+
+	res = ToString(Point{Latitude: 37.5, Longitude: -122})
+	assert.Equal(t, res, "point({latitude: 37.5, longitude: -122})")
+
+	res = ToString([]float32{1, 0.5, -2.25})
+	assert.Equal(t, res, "vecf32([1,0.5,-2.25])")
+
+	res = ToString(Vector32{0.1})
+	assert.Equal(t, res, "vecf32([0.1])")
+
+	res = ToString(90 * time.Second)
+	assert.Equal(t, res, "duration({seconds: 90})")
 }
 
 func TestMultiLabelNode(t *testing.T) {
@@ -0,0 +1,76 @@
+package falkordb
+
+import "fmt"
+
+// SchemaProvider resolves the label, relationship type and property key ids
+// referenced by compact replies into names.
+type SchemaProvider interface {
+	Label(idx int) (string, error)
+	Relation(idx int) (string, error)
+	Property(idx int) (string, error)
+}
+
+// StaticSchema is a SchemaProvider backed by fixed lists of names,
+// each name's position being its id.
+type StaticSchema struct {
+	Labels     []string
+	Relations  []string
+	Properties []string
+}
+
+func staticLookup(names []string, idx int, kind string) (string, error) {
+	if idx < 0 || idx >= len(names) {
+		return "", fmt.Errorf("unknown %s index %d", kind, idx)
+	}
+	return names[idx], nil
+}
+
+// Label returns the name of label idx.
+func (s StaticSchema) Label(idx int) (string, error) {
+	return staticLookup(s.Labels, idx, "label")
+}
+
+// Relation returns the name of relationship type idx.
+func (s StaticSchema) Relation(idx int) (string, error) {
+	return staticLookup(s.Relations, idx, "relationship")
+}
+
+// Property returns the name of property key idx.
+func (s StaticSchema) Property(idx int) (string, error) {
+	return staticLookup(s.Properties, idx, "property")
+}
+
+// Decoder decodes raw GRAPH.QUERY replies issued with the --compact flag,
+// independently of a live connection.
+type Decoder struct {
+	schema SchemaProvider
+}
+
+// NewDecoder creates a Decoder resolving schema ids through schema.
+func NewDecoder(schema SchemaProvider) *Decoder {
+	return &Decoder{schema: schema}
+}
+
+// Decode decodes reply into a QueryResult.
+// Besides the types produced by go-redis, replies may hold []byte strings
+// and int integers as returned by other Redis client libraries.
+func (d *Decoder) Decode(reply interface{}) (*QueryResult, error) {
+	return decodeQueryResult(nil, d.schema, normalizeReply(reply))
+}
+
+// normalizeReply converts the element types of reply to the ones produced by go-redis.
+func normalizeReply(reply interface{}) interface{} {
+	switch v := reply.(type) {
+	case []byte:
+		return string(v)
+	case int:
+		return int64(v)
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = normalizeReply(e)
+		}
+		return out
+	}
+	return reply
+}
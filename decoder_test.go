@@ -0,0 +1,55 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoder_Decode(t *testing.T) {
+	schema := StaticSchema{
+		Labels:     []string{"Person"},
+		Relations:  []string{"KNOWS"},
+		Properties: []string{"name", "since"},
+	}
+
+	// replies as produced by client libraries reporting strings as []byte
+	node := func(id int, name string) []interface{} {
+		return arr(id, arr(0), arr(arr(0, int(VALUE_STRING), []byte(name))))
+	}
+	edge := arr(5, 0, 1, 2, arr(arr(1, int(VALUE_INTEGER), 2019)))
+	reply := arr(
+		arr(arr(int(COLUMN_SCALAR), []byte("a")), arr(int(COLUMN_SCALAR), []byte("r")), arr(int(COLUMN_SCALAR), []byte("b"))),
+		arr(arr(arr(int(VALUE_NODE), node(1, "Alice")), arr(int(VALUE_EDGE), edge), arr(int(VALUE_NODE), node(2, "Bob")))),
+		arr([]byte("Cached execution: 0"), []byte("Query internal execution time: 0.2 milliseconds")),
+	)
+
+	qr, err := NewDecoder(schema).Decode(reply)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.2, qr.InternalExecutionTime())
+
+	assert.True(t, qr.Next())
+	r := qr.Record()
+	a, _ := r.Get("a")
+	e, _ := r.Get("r")
+	b, _ := r.Get("b")
+	assert.Equal(t, "Alice", a.(*Node).GetProperty("name"))
+	assert.Equal(t, []string{"Person"}, b.(*Node).Labels)
+	assert.Equal(t, "KNOWS", e.(*Edge).Relation)
+	assert.Equal(t, int64(2019), e.(*Edge).GetProperty("since"))
+	assert.Equal(t, uint64(1), e.(*Edge).SourceNodeID())
+	assert.Equal(t, uint64(2), e.(*Edge).DestNodeID())
+
+	_, err = NewDecoder(StaticSchema{}).Decode(reply)
+	assert.ErrorContains(t, err, "unknown label index 0")
+}
+
+func TestQueryResultNewWithoutGraph(t *testing.T) {
+	qr, err := QueryResultNew(nil, sampleReply(arr(int64(VALUE_INTEGER), int64(1))))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, qr.NodesCreated())
+
+	// schema ids can't be resolved without a graph
+	_, err = QueryResultNew(nil, sampleReply(arr(int64(VALUE_NODE), sampleNode)))
+	assert.Error(t, err)
+}
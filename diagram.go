@@ -0,0 +1,134 @@
+package falkordb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiagramOptions control how nodes and edges are captioned by DOT and Mermaid.
+// Nodes are captioned by their labels, followed by the value of their caption
+// property if set, edges by their relationship type, followed by the value
+// of the edge caption property if set. Nodes without labels nor caption are
+// captioned by their ID.
+type DiagramOptions struct {
+	nodeCaption   string
+	labelCaptions map[string]string
+	edgeCaption   string
+}
+
+// NewDiagramOptions instantiates a new DiagramOptions struct.
+func NewDiagramOptions() *DiagramOptions {
+	return &DiagramOptions{labelCaptions: make(map[string]string)}
+}
+
+// SetNodeCaption sets the property captioning nodes.
+func (options *DiagramOptions) SetNodeCaption(property string) *DiagramOptions {
+	options.nodeCaption = property
+	return options
+}
+
+// SetLabelCaption sets the property captioning nodes labeled label,
+// overriding the node caption property.
+func (options *DiagramOptions) SetLabelCaption(label string, property string) *DiagramOptions {
+	options.labelCaptions[label] = property
+	return options
+}
+
+// SetEdgeCaption sets the property captioning edges.
+func (options *DiagramOptions) SetEdgeCaption(property string) *DiagramOptions {
+	options.edgeCaption = property
+	return options
+}
+
+// nodeCaptionLines returns the lines captioning n.
+func (options *DiagramOptions) nodeCaptionLines(n *Node) []string {
+	var lines []string
+	if len(n.Labels) > 0 {
+		lines = append(lines, strings.Join(n.Labels, ":"))
+	}
+
+	property := options.nodeCaption
+	for _, l := range n.Labels {
+		if p, ok := options.labelCaptions[l]; ok {
+			property = p
+			break
+		}
+	}
+	if v := n.GetProperty(property); property != "" && v != nil {
+		lines = append(lines, attributeValue(v))
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, fmt.Sprintf("#%d", n.ID))
+	}
+	return lines
+}
+
+func (options *DiagramOptions) edgeCaptionLines(e *Edge) []string {
+	lines := []string{e.Relation}
+	if v := e.GetProperty(options.edgeCaption); options.edgeCaption != "" && v != nil {
+		lines = append(lines, attributeValue(v))
+	}
+	return lines
+}
+
+// DOT renders the distinct nodes and edges held by the result, including those
+// within paths, arrays and maps, as a Graphviz digraph.
+// Endpoints of the edges which are not part of the result are rendered as well.
+func (qr *QueryResult) DOT(options *DiagramOptions) string {
+	if options == nil {
+		options = NewDiagramOptions()
+	}
+	s := resultEntities(qr)
+
+	var b strings.Builder
+	b.WriteString("digraph G {\n")
+	for _, n := range s.nodes {
+		fmt.Fprintf(&b, "  n%d [label=%s];\n", n.ID, dotString(options.nodeCaptionLines(n)))
+	}
+	for _, e := range s.edges {
+		fmt.Fprintf(&b, "  n%d -> n%d [label=%s];\n", e.SourceNodeID(), e.DestNodeID(), dotString(options.edgeCaptionLines(e)))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid renders the distinct nodes and edges held by the result, including
+// those within paths, arrays and maps, as a left to right Mermaid flowchart.
+// Endpoints of the edges which are not part of the result are rendered as well.
+func (qr *QueryResult) Mermaid(options *DiagramOptions) string {
+	if options == nil {
+		options = NewDiagramOptions()
+	}
+	s := resultEntities(qr)
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, n := range s.nodes {
+		fmt.Fprintf(&b, "  n%d[%s]\n", n.ID, mermaidString(options.nodeCaptionLines(n)))
+	}
+	for _, e := range s.edges {
+		fmt.Fprintf(&b, "  n%d -->|%s| n%d\n", e.SourceNodeID(), mermaidString(options.edgeCaptionLines(e)), e.DestNodeID())
+	}
+	return b.String()
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", "")
+
+// dotString quotes lines as a DOT string, one line per caption line.
+func dotString(lines []string) string {
+	for i, l := range lines {
+		lines[i] = dotEscaper.Replace(l)
+	}
+	return `"` + strings.Join(lines, `\n`) + `"`
+}
+
+var mermaidEscaper = strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", "<br/>", "\r", "")
+
+// mermaidString quotes lines as a Mermaid string, one line per caption line.
+func mermaidString(lines []string) string {
+	for i, l := range lines {
+		lines[i] = mermaidEscaper.Replace(l)
+	}
+	return `"` + strings.Join(lines, "<br/>") + `"`
+}
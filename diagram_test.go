@@ -0,0 +1,38 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagrams(t *testing.T) {
+	qr := sampleEntities(t)
+
+	assert.Equal(t, `digraph G {
+  n0 [label="Person"];
+  n2 [label="#2"];
+  n1 [label="#1"];
+  n0 -> n1 [label="Visited"];
+}
+`, qr.DOT(nil))
+
+	options := NewDiagramOptions().SetNodeCaption("age").SetLabelCaption("Person", "name").SetEdgeCaption("year")
+	assert.Equal(t, `digraph G {
+  n0 [label="Person\na & b"];
+  n2 [label="0.5"];
+  n1 [label="#1"];
+  n0 -> n1 [label="Visited\n2017"];
+}
+`, qr.DOT(options))
+
+	assert.Equal(t, `flowchart LR
+  n0["Person<br/>a & b"]
+  n2["0.5"]
+  n1["#1"]
+  n0 -->|"Visited<br/>2017"| n1
+`, qr.Mermaid(options))
+
+	assert.Equal(t, `"a\"b\\c\nd"`, dotString([]string{"a\"b\\c", "d"}))
+	assert.Equal(t, `"#quot;x#quot; #lt;y#gt;<br/>z"`, mermaidString([]string{`"x" <y>`, "z"}))
+}
@@ -1,6 +1,7 @@
 package falkordb
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -55,7 +56,7 @@ func (e Edge) SourceNodeID() uint64 {
 
 // DestNodeID returns edge destination node ID
 func (e Edge) DestNodeID() uint64 {
-	if e.Source != nil {
+	if e.Destination != nil {
 		return e.Destination.ID
 	} else {
 		return e.destNodeID
@@ -79,7 +80,7 @@ func (e Edge) String() string {
 
 // Encode makes Edge satisfy the Stringer interface
 func (e Edge) Encode() string {
-	s := []string{"(", e.Source.Alias, ")"}
+	s := []string{"(", endpointAlias(e.Source), ")"}
 
 	s = append(s, "-[")
 
@@ -99,7 +100,70 @@ func (e Edge) Encode() string {
 	}
 
 	s = append(s, "]->")
-	s = append(s, "(", e.Destination.Alias, ")")
+	s = append(s, "(", endpointAlias(e.Destination), ")")
 
 	return strings.Join(s, "")
 }
+
+// endpointAlias returns the alias of n, empty for unresolved endpoints.
+func endpointAlias(n *Node) string {
+	if n == nil {
+		return ""
+	}
+	return n.Alias
+}
+
+// edgeJSON is the JSON encoding of an edge, shared by MarshalJSON and Export.
+type edgeJSON struct {
+	// Type is "edge" within NDJSON exports, empty otherwise.
+	Type        string                 `json:"type,omitempty"`
+	ID          uint64                 `json:"id"`
+	Relation    string                 `json:"relation"`
+	Source      uint64                 `json:"source"`
+	Destination uint64                 `json:"destination"`
+	Properties  map[string]interface{} `json:"properties"`
+}
+
+func newEdgeJSON(e *Edge) (edgeJSON, error) {
+	props, err := toJSONMap(e.Properties)
+	if err != nil {
+		return edgeJSON{}, err
+	}
+	return edgeJSON{ID: e.ID, Relation: e.Relation,
+		Source: e.SourceNodeID(), Destination: e.DestNodeID(), Properties: props}, nil
+}
+
+func (j edgeJSON) edge() (*Edge, error) {
+	props, err := fromJSONMap(j.Properties)
+	if err != nil {
+		return nil, err
+	}
+	return &Edge{ID: j.ID, Relation: j.Relation, Properties: props, srcNodeID: j.Source, destNodeID: j.Destination}, nil
+}
+
+// MarshalJSON encodes the edge as an object holding its id, relationship type,
+// the IDs of its endpoints and its properties.
+// Property values without a JSON counterpart are encoded as objects tagging their type.
+func (e Edge) MarshalJSON() ([]byte, error) {
+	j, err := newEdgeJSON(&e)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes an edge encoded by MarshalJSON.
+// Only the IDs of its endpoints are known, Source and Destination are left nil.
+func (e *Edge) UnmarshalJSON(data []byte) error {
+	var j edgeJSON
+	err := unmarshalJSON(data, &j)
+	if err != nil {
+		return err
+	}
+	decoded, err := j.edge()
+	if err != nil {
+		return err
+	}
+	*e = *decoded
+	return nil
+}
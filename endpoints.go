@@ -0,0 +1,83 @@
+package falkordb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ResolveEndpoints fetches the Source and Destination of the edge when unset.
+// Only edges returned by a query against a graph can be resolved.
+func (e *Edge) ResolveEndpoints(c context.Context) error {
+	if e.Source != nil && e.Destination != nil {
+		return nil
+	}
+	return resolveEndpoints(c, e.graph, []*Edge{e}, nil)
+}
+
+// ResolveEndpoints sets the Source and Destination of every edge held by the
+// result, including those within paths, arrays and maps.
+// Endpoints which are part of the result are used as is, the others are
+// fetched in a single query.
+func (qr *QueryResult) ResolveEndpoints(c context.Context) error {
+	known := make(map[uint64]*Node)
+	var edges []*Edge
+	for _, r := range qr.results {
+		for _, v := range r.values {
+			walkEntities(v, func(n *Node) {
+				if n != nil && known[n.ID] == nil {
+					known[n.ID] = n
+				}
+			}, func(e *Edge) {
+				if e != nil && (e.Source == nil || e.Destination == nil) {
+					edges = append(edges, e)
+				}
+			})
+		}
+	}
+	return resolveEndpoints(c, qr.graph, edges, known)
+}
+
+// resolveEndpoints sets the endpoints of edges, taking them from known
+// when present and fetching the others from g, which may be nil if none
+// needs fetching.
+func resolveEndpoints(c context.Context, g *Graph, edges []*Edge, known map[uint64]*Node) error {
+	var missing []uint64
+	for _, e := range edges {
+		for _, id := range []uint64{e.SourceNodeID(), e.DestNodeID()} {
+			if known[id] == nil {
+				missing = append(missing, id)
+			}
+		}
+	}
+
+	if len(missing) > 0 && g == nil {
+		return errors.New("edges are not attached to a graph")
+	}
+	fetched, err := g.getNodesByID(c, missing)
+	if err != nil {
+		return err
+	}
+	lookup := func(id uint64) (*Node, error) {
+		if n := known[id]; n != nil {
+			return n, nil
+		}
+		if n := fetched[id]; n != nil {
+			return n, nil
+		}
+		return nil, fmt.Errorf("node %d not found", id)
+	}
+
+	for _, e := range edges {
+		src, err := lookup(e.SourceNodeID())
+		if err != nil {
+			return err
+		}
+		dst, err := lookup(e.DestNodeID())
+		if err != nil {
+			return err
+		}
+		e.Source, e.Destination = src, dst
+	}
+	return nil
+}
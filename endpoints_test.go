@@ -0,0 +1,40 @@
+package falkordb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveKnownEndpoints(t *testing.T) {
+	country := arr(int64(VALUE_NODE), arr(int64(1), arr(int64(1)), arr()))
+	reply := arr(
+		arr(arr(int64(COLUMN_SCALAR), "a"), arr(int64(COLUMN_SCALAR), "e"), arr(int64(COLUMN_SCALAR), "b")),
+		arr(arr(arr(int64(VALUE_NODE), sampleNode), arr(int64(VALUE_EDGE), sampleEdge), country)),
+		sampleStats,
+	)
+	qr, err := sampleDecoder.Decode(reply)
+	assert.NoError(t, err)
+
+	// endpoints returned alongside the edges need no round trip
+	assert.NoError(t, qr.ResolveEndpoints(context.Background()))
+	qr.Next()
+	r := qr.Record()
+	a, _ := r.GetByIndex(0)
+	e, _ := r.GetByIndex(1)
+	b, _ := r.GetByIndex(2)
+	assert.Same(t, a, e.(*Edge).Source)
+	assert.Same(t, b, e.(*Edge).Destination)
+	assert.Equal(t, "()-[:Visited{year:2017}]->()", e.(*Edge).Encode())
+
+	// others can't be fetched without a graph
+	qr, err = sampleDecoder.Decode(sampleReply(arr(int64(VALUE_EDGE), sampleEdge)))
+	assert.NoError(t, err)
+	assert.Error(t, qr.ResolveEndpoints(context.Background()))
+}
+
+func TestEncodeUnresolvedEdge(t *testing.T) {
+	e := EdgeNew("KNOWS", nil, nil, nil)
+	assert.Equal(t, "()-[:KNOWS]->()", e.Encode())
+}
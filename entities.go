@@ -0,0 +1,117 @@
+package falkordb
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetNodesByID fetches the nodes with the given IDs in a single query.
+// Nodes which don't exist are missing from the returned map.
+func (g *Graph) GetNodesByID(ids []uint64) (map[uint64]*Node, error) {
+	return g.getNodesByID(ctx, ids)
+}
+
+func (g *Graph) getNodesByID(c context.Context, ids []uint64) (map[uint64]*Node, error) {
+	nodes := make(map[uint64]*Node, len(ids))
+	if len(ids) == 0 {
+		return nodes, nil
+	}
+
+	seen := make(map[uint64]bool, len(ids))
+	params := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			params = append(params, int64(id))
+		}
+	}
+
+	qr, err := g.queryContext(c, "GRAPH.RO_QUERY", "MATCH (n) WHERE ID(n) IN $ids RETURN n",
+		map[string]interface{}{"ids": params}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range qr.results {
+		n, ok := r.values[0].(*Node)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a node, got %T", ErrMalformedReply, r.values[0])
+		}
+		nodes[n.ID] = n
+	}
+	return nodes, nil
+}
+
+// GetNodes fetches the nodes with the given IDs in a single query.
+// Nodes are returned in the order of ids, nil for those which don't exist.
+func (g *Graph) GetNodes(ids []uint64) ([]*Node, error) {
+	found, err := g.GetNodesByID(ids)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*Node, len(ids))
+	for i, id := range ids {
+		nodes[i] = found[id]
+	}
+	return nodes, nil
+}
+
+// GetNode fetches the node with the given ID.
+// ErrEntityNotFound is returned if it doesn't exist.
+func (g *Graph) GetNode(id uint64) (*Node, error) {
+	found, err := g.GetNodesByID([]uint64{id})
+	if err != nil {
+		return nil, err
+	}
+	n, ok := found[id]
+	if !ok {
+		return nil, fmt.Errorf("node %d: %w", id, ErrEntityNotFound)
+	}
+	return n, nil
+}
+
+// GetEdge fetches the edge with the given ID, matched the same way as
+// the nodes of GetNodesByID.
+// ErrEntityNotFound is returned if it doesn't exist.
+func (g *Graph) GetEdge(id uint64) (*Edge, error) {
+	qr, err := g.ROQuery("MATCH ()-[e]->() WHERE ID(e) IN $ids RETURN e",
+		map[string]interface{}{"ids": []interface{}{int64(id)}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.results) == 0 {
+		return nil, fmt.Errorf("edge %d: %w", id, ErrEntityNotFound)
+	}
+	e, ok := qr.results[0].values[0].(*Edge)
+	if !ok {
+		return nil, fmt.Errorf("%w: expected an edge, got %T", ErrMalformedReply, qr.results[0].values[0])
+	}
+	return e, nil
+}
+
+// DeleteNode deletes the node with the given ID along with its edges.
+// ErrEntityNotFound is returned if it doesn't exist.
+func (g *Graph) DeleteNode(id uint64) error {
+	qr, err := g.Query("MATCH (n) WHERE ID(n) = $id DELETE n",
+		map[string]interface{}{"id": int64(id)}, nil)
+	if err != nil {
+		return err
+	}
+	if qr.NodesDeleted() == 0 {
+		return fmt.Errorf("node %d: %w", id, ErrEntityNotFound)
+	}
+	return nil
+}
+
+// DeleteEdge deletes the edge with the given ID.
+// ErrEntityNotFound is returned if it doesn't exist.
+func (g *Graph) DeleteEdge(id uint64) error {
+	qr, err := g.Query("MATCH ()-[e]->() WHERE ID(e) = $id DELETE e",
+		map[string]interface{}{"id": int64(id)}, nil)
+	if err != nil {
+		return err
+	}
+	if qr.RelationshipsDeleted() == 0 {
+		return fmt.Errorf("edge %d: %w", id, ErrEntityNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package falkordb
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// entitySet holds distinct nodes and edges, in the order they were added.
+type entitySet struct {
+	nodes   []*Node
+	edges   []*Edge
+	nodeIDs map[uint64]bool
+	edgeIDs map[uint64]bool
+}
+
+func newEntitySet() *entitySet {
+	return &entitySet{nodeIDs: make(map[uint64]bool), edgeIDs: make(map[uint64]bool)}
+}
+
+func (s *entitySet) addNode(n *Node) {
+	if n != nil && !s.nodeIDs[n.ID] {
+		s.nodeIDs[n.ID] = true
+		s.nodes = append(s.nodes, n)
+	}
+}
+
+func (s *entitySet) addEdge(e *Edge) {
+	if e != nil && !s.edgeIDs[e.ID] {
+		s.edgeIDs[e.ID] = true
+		s.edges = append(s.edges, e)
+	}
+}
+
+// collect adds the nodes and edges found in v, including those nested
+// in paths, arrays and maps.
+func (s *entitySet) collect(v interface{}) {
+	walkEntities(v, s.addNode, s.addEdge)
+}
+
+// walkEntities calls node and edge for every node and edge found in v,
+// including those nested in paths, arrays and maps.
+func walkEntities(v interface{}, node func(*Node), edge func(*Edge)) {
+	switch v := v.(type) {
+	case *Node:
+		node(v)
+	case *Edge:
+		edge(v)
+	case Path:
+		for _, n := range v.Nodes {
+			node(n)
+		}
+		for _, e := range v.Edges {
+			edge(e)
+		}
+	case []interface{}:
+		for _, e := range v {
+			walkEntities(e, node, edge)
+		}
+	case map[string]interface{}:
+		// visit keys in order so the entities order is deterministic
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			walkEntities(v[k], node, edge)
+		}
+	}
+}
+
+// addMissingEndpoints adds a bare node, holding only its ID, for every
+// edge endpoint which isn't part of the set.
+func (s *entitySet) addMissingEndpoints() {
+	for _, e := range s.edges {
+		for _, id := range []uint64{e.SourceNodeID(), e.DestNodeID()} {
+			s.addNode(&Node{ID: id, Properties: map[string]interface{}{}})
+		}
+	}
+}
+
+func (s *entitySet) eachNode(fn func(*Node) error) error {
+	for _, n := range s.nodes {
+		err := fn(n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *entitySet) eachEdge(fn func(*Edge) error) error {
+	for _, e := range s.edges {
+		err := fn(e)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resultEntities collects the nodes and edges of a query result,
+// along with the endpoints of its edges.
+func resultEntities(qr *QueryResult) *entitySet {
+	s := newEntitySet()
+	for _, r := range qr.results {
+		for _, v := range r.values {
+			s.collect(v)
+		}
+	}
+	s.addMissingEndpoints()
+	return s
+}
+
+// graphEntities collects all nodes and edges of the graph.
+func (g *Graph) graphEntities() (*entitySet, error) {
+	s := newEntitySet()
+	err := g.scanNodes(func(n *Node) error {
+		s.addNode(n)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = g.scanEdges(func(e *Edge) error {
+		s.addEdge(e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// attribute is a property declared by the graph exchange formats.
+type attribute struct {
+	id   string
+	name string
+	// title is the name the attribute is declared under, which differs
+	// from name when the property shares the name of a synthetic attribute.
+	title string
+	typ   string
+}
+
+// attributeType is the type declaring values of v, integers being "long",
+// floats "double", booleans "boolean" and all others "string".
+func attributeType(v interface{}) string {
+	switch v.(type) {
+	case int64, int:
+		return "long"
+	case float64:
+		return "double"
+	case bool:
+		return "boolean"
+	}
+	return "string"
+}
+
+// declareAttributes declares every property found in props, sorted by name,
+// with ids made of prefix and the attribute's index. Properties of
+// differing types are declared as doubles if all numeric, as strings otherwise.
+// Properties named after one of the reserved synthetic attributes are
+// declared under their name suffixed by " (property)".
+func declareAttributes(prefix string, props []map[string]interface{}, reserved ...string) []attribute {
+	types := make(map[string]string)
+	for _, p := range props {
+		for k, v := range p {
+			if v == nil {
+				continue
+			}
+			t := attributeType(v)
+			switch prev, ok := types[k]; {
+			case !ok || prev == t:
+				types[k] = t
+			case (prev == "long" || prev == "double") && (t == "long" || t == "double"):
+				types[k] = "double"
+			default:
+				types[k] = "string"
+			}
+		}
+	}
+
+	names := make([]string, 0, len(types))
+	for k := range types {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	attrs := make([]attribute, len(names))
+	for i, name := range names {
+		attrs[i] = attribute{id: prefix + strconv.Itoa(i), name: name, title: name, typ: types[name]}
+		for _, r := range reserved {
+			if name == r {
+				attrs[i].title = name + " (property)"
+			}
+		}
+	}
+	return attrs
+}
+
+// attributeValue formats v as an attribute value, values without a
+// counterpart in the exchange formats are formatted as JSON.
+func attributeValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	j, err := toJSONValue(v)
+	if err == nil {
+		b, err := json.Marshal(j)
+		if err == nil {
+			return string(b)
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+func nodeProperties(nodes []*Node) []map[string]interface{} {
+	props := make([]map[string]interface{}, len(nodes))
+	for i, n := range nodes {
+		props[i] = n.Properties
+	}
+	return props
+}
+
+func edgeProperties(edges []*Edge) []map[string]interface{} {
+	props := make([]map[string]interface{}, len(edges))
+	for i, e := range edges {
+		props[i] = e.Properties
+	}
+	return props
+}
@@ -3,5 +3,10 @@ package falkordb
 import "errors"
 
 var (
-	ErrRecordNoValue = errors.New("no value")
+	ErrRecordNoValue  = errors.New("no value")
+	ErrNoRows         = errors.New("no rows in result set")
+	ErrMultipleRows   = errors.New("multiple rows in result set")
+	ErrMalformedReply = errors.New("malformed reply")
+	ErrNoPath         = errors.New("no path between nodes")
+	ErrEntityNotFound = errors.New("entity not found")
 )
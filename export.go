@@ -0,0 +1,433 @@
+package falkordb
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ExportFormat is the format of the documents written by Export.
+type ExportFormat int
+
+const (
+	// EXPORT_JSON writes a single object holding a "nodes" and an "edges" array.
+	EXPORT_JSON ExportFormat = iota
+	// EXPORT_NDJSON writes one object per line, nodes first, told apart by their "type".
+	EXPORT_NDJSON
+	// EXPORT_GRAPHML writes a GraphML document, which Import doesn't read.
+	EXPORT_GRAPHML
+	// EXPORT_GEXF writes a GEXF 1.3 document, which Import doesn't read.
+	EXPORT_GEXF
+)
+
+// exportPageSize is the number of node IDs covered by each query scanning the graph.
+const exportPageSize = 1000
+
+// scanNodes calls fn with every node of the graph, in ID order,
+// fetching them one range of IDs at a time.
+func (g *Graph) scanNodes(fn func(*Node) error) error {
+	return g.scan("MATCH (n) WHERE ID(n) >= $from RETURN min(ID(n))",
+		"MATCH (n) WHERE ID(n) >= $from AND ID(n) < $to RETURN n ORDER BY ID(n)",
+		func(v interface{}) error {
+			n, ok := v.(*Node)
+			if !ok {
+				return fmt.Errorf("expected a node, got %T", v)
+			}
+			return fn(n)
+		})
+}
+
+// scanEdges calls fn with every edge of the graph, ordered by the ID of
+// their source node, fetching the edges of one range of source IDs at a time.
+func (g *Graph) scanEdges(fn func(*Edge) error) error {
+	return g.scan("MATCH (n)-[]->() WHERE ID(n) >= $from RETURN min(ID(n))",
+		"MATCH (n)-[e]->() WHERE ID(n) >= $from AND ID(n) < $to RETURN e ORDER BY ID(n), ID(e)",
+		func(v interface{}) error {
+			e, ok := v.(*Edge)
+			if !ok {
+				return fmt.Errorf("expected an edge, got %T", v)
+			}
+			return fn(e)
+		})
+}
+
+// scan calls fn with the single value of every row returned by pageQuery,
+// run for successive ranges of node IDs [$from, $to).
+// nextQuery returns the smallest ID from $from on which a page holds rows,
+// it is run to find the first page and to skip the ID gaps following an empty
+// page. The scan stops once it returns null.
+func (g *Graph) scan(nextQuery string, pageQuery string, fn func(interface{}) error) error {
+	next := func(from int64) (*int64, error) {
+		return ROQueryScalar[*int64](g, nextQuery, map[string]interface{}{"from": from}, nil)
+	}
+
+	from, err := next(0)
+	for err == nil && from != nil {
+		to := *from + exportPageSize
+		var qr *QueryResult
+		qr, err = g.ROQuery(pageQuery, map[string]interface{}{"from": *from, "to": to}, nil)
+		if err != nil {
+			return err
+		}
+		for _, r := range qr.results {
+			err = fn(r.values[0])
+			if err != nil {
+				return err
+			}
+		}
+		if len(qr.results) > 0 {
+			*from = to
+		} else {
+			from, err = next(to)
+		}
+	}
+	return err
+}
+
+// Export writes all nodes and edges of the graph to w, in the given format.
+// Property values without a JSON counterpart, such as points and temporal values,
+// are written as objects tagging their type, which Import decodes.
+// GraphML and GEXF documents are built in memory before being written.
+func (g *Graph) Export(w io.Writer, format ExportFormat) error {
+	switch format {
+	case EXPORT_JSON, EXPORT_NDJSON:
+		return writeJSONEntities(w, format, g.scanNodes, g.scanEdges)
+	case EXPORT_GRAPHML, EXPORT_GEXF:
+		s, err := g.graphEntities()
+		if err != nil {
+			return err
+		}
+		return s.write(w, format)
+	}
+	return fmt.Errorf("unknown export format %d", format)
+}
+
+// Export writes the distinct nodes and edges held by the result, including
+// those within paths, arrays and maps, to w in the given format.
+// Endpoints of the edges which are not part of the result are written
+// as nodes without labels nor properties.
+func (qr *QueryResult) Export(w io.Writer, format ExportFormat) error {
+	return resultEntities(qr).write(w, format)
+}
+
+func (s *entitySet) write(w io.Writer, format ExportFormat) error {
+	switch format {
+	case EXPORT_JSON, EXPORT_NDJSON:
+		return writeJSONEntities(w, format, s.eachNode, s.eachEdge)
+	case EXPORT_GRAPHML:
+		return writeGraphML(w, s)
+	case EXPORT_GEXF:
+		return writeGEXF(w, s)
+	}
+	return fmt.Errorf("unknown export format %d", format)
+}
+
+// writeJSONEntities writes the nodes and then the edges produced by the given functions.
+func writeJSONEntities(w io.Writer, format ExportFormat, nodes func(func(*Node) error) error, edges func(func(*Edge) error) error) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	first := true
+	write := func(v interface{}) error {
+		if format == EXPORT_JSON && !first {
+			bw.WriteString(",")
+		}
+		first = false
+		return enc.Encode(v)
+	}
+
+	kind := func(t string) string {
+		if format == EXPORT_NDJSON {
+			return t
+		}
+		return ""
+	}
+
+	if format == EXPORT_JSON {
+		bw.WriteString("{\"nodes\":[\n")
+	}
+	err := nodes(func(n *Node) error {
+		j, err := newNodeJSON(n)
+		if err != nil {
+			return fmt.Errorf("node %d: %w", n.ID, err)
+		}
+		j.Type = kind("node")
+		return write(j)
+	})
+	if err != nil {
+		return err
+	}
+
+	if format == EXPORT_JSON {
+		bw.WriteString("],\"edges\":[\n")
+		first = true
+	}
+	err = edges(func(e *Edge) error {
+		j, err := newEdgeJSON(e)
+		if err != nil {
+			return fmt.Errorf("edge %d: %w", e.ID, err)
+		}
+		j.Type = kind("edge")
+		return write(j)
+	})
+	if err != nil {
+		return err
+	}
+
+	if format == EXPORT_JSON {
+		bw.WriteString("]}\n")
+	}
+	return bw.Flush()
+}
+
+// Import recreates the nodes and edges of a document written by Export.
+// Entities are assigned new IDs, edges are attached to the nodes created
+// for their original endpoints; nodes must thus precede the edges referring to them.
+func (g *Graph) Import(r io.Reader, format ExportFormat) (Stats, error) {
+	imp := &graphImport{
+		graph:   g,
+		options: NewBulkOptions(),
+		ids:     make(map[uint64]uint64),
+		nodes:   make(map[string]*labeledRows),
+		edges:   make(map[string][]BulkEdge),
+	}
+
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var err error
+	switch format {
+	case EXPORT_JSON:
+		err = imp.readJSON(dec)
+	case EXPORT_NDJSON:
+		err = imp.readNDJSON(dec)
+	default:
+		err = fmt.Errorf("cannot import format %d", format)
+	}
+	if err == nil {
+		err = imp.flush()
+	}
+	return imp.stats, err
+}
+
+// labeledRows are the pending rows of nodes sharing the same labels,
+// labels being their :`label` pattern.
+type labeledRows struct {
+	labels string
+	rows   []interface{}
+}
+
+type graphImport struct {
+	graph   *Graph
+	options *BulkOptions
+	// ids maps the IDs of the exported nodes to the IDs of the created ones.
+	ids   map[uint64]uint64
+	nodes map[string]*labeledRows // by labels pattern
+	edges map[string][]BulkEdge
+	stats Stats
+}
+
+func (imp *graphImport) readJSON(dec *json.Decoder) error {
+	err := expectDelim(dec, '{')
+	if err != nil {
+		return err
+	}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := t.(string)
+		if key != "nodes" && key != "edges" {
+			var skip json.RawMessage
+			err = dec.Decode(&skip)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = expectDelim(dec, '[')
+		if err != nil {
+			return err
+		}
+		for dec.More() {
+			var raw json.RawMessage
+			err = dec.Decode(&raw)
+			if err != nil {
+				return err
+			}
+			err = imp.add(strings.TrimSuffix(key, "s"), raw)
+			if err != nil {
+				return err
+			}
+		}
+		err = expectDelim(dec, ']')
+		if err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+func (imp *graphImport) readNDJSON(dec *json.Decoder) error {
+	for {
+		var raw json.RawMessage
+		err := dec.Decode(&raw)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var entity struct {
+			Type string `json:"type"`
+		}
+		err = json.Unmarshal(raw, &entity)
+		if err != nil {
+			return err
+		}
+		err = imp.add(entity.Type, raw)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if t != delim {
+		return fmt.Errorf("expected %v, got %v", delim, t)
+	}
+	return nil
+}
+
+// add queues the entity of the given type encoded by raw,
+// in the encoding of Node.MarshalJSON or Edge.MarshalJSON.
+func (imp *graphImport) add(typ string, raw json.RawMessage) error {
+	switch typ {
+	case "node":
+		var j nodeJSON
+		err := unmarshalJSON(raw, &j)
+		if err != nil {
+			return err
+		}
+		n, err := j.node()
+		if err != nil {
+			return fmt.Errorf("node %d: %w", j.ID, err)
+		}
+		return imp.addNode(n)
+	case "edge":
+		var j edgeJSON
+		err := unmarshalJSON(raw, &j)
+		if err != nil {
+			return err
+		}
+		e, err := j.edge()
+		if err != nil {
+			return fmt.Errorf("edge %d: %w", j.ID, err)
+		}
+		return imp.addEdge(e)
+	}
+	return fmt.Errorf("unknown entity type %q", typ)
+}
+
+func (imp *graphImport) addNode(n *Node) error {
+	labels := ""
+	for _, l := range n.Labels {
+		labels += ":" + quoteIdentifier(l)
+	}
+	group := imp.nodes[labels]
+	if group == nil {
+		group = &labeledRows{labels: labels}
+		imp.nodes[labels] = group
+	}
+	group.rows = append(group.rows, map[string]interface{}{"id": int64(n.ID), "props": n.Properties})
+	if len(group.rows) >= imp.options.batchSize {
+		return imp.flushNodes(group)
+	}
+	return nil
+}
+
+func (imp *graphImport) addEdge(e *Edge) error {
+	// endpoints are resolved once all preceding nodes are created
+	if len(imp.nodes) > 0 {
+		err := imp.flushAllNodes()
+		if err != nil {
+			return err
+		}
+	}
+	src, ok := imp.ids[e.SourceNodeID()]
+	if !ok {
+		return fmt.Errorf("edge %d: unknown source node %d", e.ID, e.SourceNodeID())
+	}
+	dst, ok := imp.ids[e.DestNodeID()]
+	if !ok {
+		return fmt.Errorf("edge %d: unknown destination node %d", e.ID, e.DestNodeID())
+	}
+	if e.Relation == "" {
+		return fmt.Errorf("edge %d: missing relationship type", e.ID)
+	}
+	rows := append(imp.edges[e.Relation], BulkEdge{Source: int64(src), Destination: int64(dst), Properties: e.Properties})
+	imp.edges[e.Relation] = rows
+	if len(rows) >= imp.options.batchSize {
+		return imp.flushEdges(e.Relation)
+	}
+	return nil
+}
+
+func (imp *graphImport) flushNodes(group *labeledRows) error {
+	q := fmt.Sprintf("UNWIND $rows AS row CREATE (n%s) SET n = row.props RETURN row.id, ID(n)", group.labels)
+	stats, err := imp.graph.unwindEach(q, group.rows, imp.options, func(qr *QueryResult) error {
+		for _, r := range qr.results {
+			from, ok1 := r.values[0].(int64)
+			to, ok2 := r.values[1].(int64)
+			if !ok1 || !ok2 {
+				return errors.New("unexpected node ID")
+			}
+			imp.ids[uint64(from)] = uint64(to)
+		}
+		return nil
+	})
+	imp.stats = imp.stats.Add(stats)
+	group.rows = nil
+	return err
+}
+
+func (imp *graphImport) flushAllNodes() error {
+	for key, group := range imp.nodes {
+		err := imp.flushNodes(group)
+		if err != nil {
+			return err
+		}
+		delete(imp.nodes, key)
+	}
+	return nil
+}
+
+func (imp *graphImport) flushEdges(relation string) error {
+	stats, err := imp.graph.BulkCreateEdges(relation, EdgeEndpoint{}, EdgeEndpoint{}, imp.edges[relation], imp.options)
+	imp.stats = imp.stats.Add(stats)
+	delete(imp.edges, relation)
+	return err
+}
+
+func (imp *graphImport) flush() error {
+	err := imp.flushAllNodes()
+	if err != nil {
+		return err
+	}
+	for relation := range imp.edges {
+		err = imp.flushEdges(relation)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
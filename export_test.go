@@ -0,0 +1,180 @@
+package falkordb
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleEntities(t *testing.T) *QueryResult {
+	node := arr(int64(VALUE_NODE), arr(int64(0), arr(int64(0)), arr(
+		arr(int64(0), int64(VALUE_STRING), "a & b"),
+		arr(int64(1), int64(VALUE_INTEGER), int64(3)),
+	)))
+	other := arr(int64(VALUE_NODE), arr(int64(2), arr(), arr(arr(int64(1), int64(VALUE_DOUBLE), "0.5"))))
+	edge := arr(int64(VALUE_EDGE), sampleEdge)
+	reply := arr(
+		arr(arr(int64(COLUMN_SCALAR), "a"), arr(int64(COLUMN_SCALAR), "b")),
+		arr(arr(node, arr(int64(VALUE_ARRAY), arr(edge, node))), arr(other, arr(int64(VALUE_NULL), nil))),
+		sampleStats,
+	)
+	qr, err := sampleDecoder.Decode(reply)
+	assert.NoError(t, err)
+	return qr
+}
+
+func TestExportResult(t *testing.T) {
+	qr := sampleEntities(t)
+
+	var buf strings.Builder
+	assert.NoError(t, qr.Export(&buf, EXPORT_NDJSON))
+	assert.Equal(t, `{"type":"node","id":0,"labels":["Person"],"properties":{"age":3,"name":"a & b"}}
+{"type":"node","id":2,"labels":[],"properties":{"age":0.5}}
+{"type":"node","id":1,"labels":[],"properties":{}}
+{"type":"edge","id":1,"relation":"Visited","source":0,"destination":1,"properties":{"year":2017}}
+`, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, qr.Export(&buf, EXPORT_GRAPHML))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="labels" for="node" attr.name="labels" attr.type="string"></key>
+  <key id="relation" for="edge" attr.name="relation" attr.type="string"></key>
+  <key id="d0" for="node" attr.name="age" attr.type="double"></key>
+  <key id="d1" for="node" attr.name="name" attr.type="string"></key>
+  <key id="k0" for="edge" attr.name="year" attr.type="long"></key>
+  <graph id="G" edgedefault="directed">
+    <node id="n0">
+      <data key="labels">:Person</data>
+      <data key="d0">3</data>
+      <data key="d1">a &amp; b</data>
+    </node>
+    <node id="n2">
+      <data key="d0">0.5</data>
+    </node>
+    <node id="n1"></node>
+    <edge id="e1" source="n0" target="n1">
+      <data key="relation">Visited</data>
+      <data key="k0">2017</data>
+    </edge>
+  </graph>
+</graphml>
+`, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, qr.Export(&buf, EXPORT_GEXF))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://gexf.net/1.3" version="1.3">
+  <graph mode="static" defaultedgetype="directed">
+    <attributes class="node">
+      <attribute id="labels" title="labels" type="string"></attribute>
+      <attribute id="d0" title="age" type="double"></attribute>
+      <attribute id="d1" title="name" type="string"></attribute>
+    </attributes>
+    <attributes class="edge">
+      <attribute id="k0" title="year" type="long"></attribute>
+    </attributes>
+    <nodes>
+      <node id="0" label="Person">
+        <attvalues>
+          <attvalue for="labels" value="Person"></attvalue>
+          <attvalue for="d0" value="3"></attvalue>
+          <attvalue for="d1" value="a &amp; b"></attvalue>
+        </attvalues>
+      </node>
+      <node id="2" label="">
+        <attvalues>
+          <attvalue for="d0" value="0.5"></attvalue>
+        </attvalues>
+      </node>
+      <node id="1" label=""></node>
+    </nodes>
+    <edges>
+      <edge id="1" source="0" target="1" label="Visited">
+        <attvalues>
+          <attvalue for="k0" value="2017"></attvalue>
+        </attvalues>
+      </edge>
+    </edges>
+  </graph>
+</gexf>
+`, buf.String())
+
+	assert.Error(t, qr.Export(&buf, ExportFormat(99)))
+}
+
+func TestDeclareAttributes(t *testing.T) {
+	attrs := declareAttributes("d", []map[string]interface{}{
+		{"a": int64(1), "b": true, "c": "x", "d": nil},
+		{"a": 1.5, "b": "y", "c": []interface{}{int64(1)}},
+	}, "c")
+	assert.Equal(t, []attribute{
+		{id: "d0", name: "a", title: "a", typ: "double"},
+		{id: "d1", name: "b", title: "b", typ: "string"},
+		{id: "d2", name: "c", title: "c (property)", typ: "string"},
+	}, attrs)
+	assert.Equal(t, "[1]", attributeValue([]interface{}{int64(1)}))
+	assert.Equal(t, `{"$point":{"latitude":1.0,"longitude":2.5}}`, attributeValue(Point{Latitude: 1, Longitude: 2.5}))
+}
+
+func TestExportIdentifiers(t *testing.T) {
+	s := newEntitySet()
+	for i := uint64(0); i < 3; i++ {
+		n := NodeNew([]string{"A"}, "", map[string]interface{}{"labels": "x", "p": i})
+		n.ID = i
+		s.addNode(n)
+	}
+	e := EdgeNew("R", s.nodes[0], s.nodes[1], map[string]interface{}{"relation": "y", "q": int64(1)})
+	s.addEdge(e)
+
+	var buf strings.Builder
+	assert.NoError(t, writeGraphML(&buf, s))
+	var doc graphMLDocument
+	assert.NoError(t, xml.Unmarshal([]byte(buf.String()), &doc))
+	ids := make(map[string]bool)
+	titles := make(map[string]bool)
+	for _, k := range doc.Keys {
+		assert.False(t, ids[k.ID], "duplicate key id %s", k.ID)
+		ids[k.ID] = true
+		assert.False(t, titles[k.For+k.Name], "duplicate key name %s", k.Name)
+		titles[k.For+k.Name] = true
+	}
+	for _, n := range doc.Graph.Nodes {
+		assert.False(t, ids[n.ID], "node id %s is a key id", n.ID)
+	}
+	for _, e := range doc.Graph.Edges {
+		assert.False(t, ids[e.ID], "edge id %s is a key id", e.ID)
+	}
+
+	buf.Reset()
+	assert.NoError(t, writeGEXF(&buf, s))
+	assert.Contains(t, buf.String(), `<attribute id="labels" title="labels" type="string"></attribute>`)
+	assert.Contains(t, buf.String(), `<attribute id="d0" title="labels (property)" type="string"></attribute>`)
+}
+
+func TestExportWireFormat(t *testing.T) {
+	qr := sampleEntities(t)
+	s := resultEntities(qr)
+
+	var buf strings.Builder
+	assert.NoError(t, qr.Export(&buf, EXPORT_JSON))
+	var doc struct {
+		Nodes []*Node `json:"nodes"`
+		Edges []*Edge `json:"edges"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(buf.String()), &doc))
+	assert.Equal(t, len(s.nodes), len(doc.Nodes))
+	for i, n := range s.nodes {
+		b, err := json.Marshal(n)
+		assert.NoError(t, err)
+		exported, err := json.Marshal(doc.Nodes[i])
+		assert.NoError(t, err)
+		assert.JSONEq(t, string(b), string(exported))
+	}
+	assert.Equal(t, s.edges[0].ID, doc.Edges[0].ID)
+	assert.Equal(t, s.edges[0].Properties, doc.Edges[0].Properties)
+	assert.Equal(t, s.edges[0].DestNodeID(), doc.Edges[0].DestNodeID())
+}
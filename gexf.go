@@ -0,0 +1,124 @@
+package falkordb
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type gexfDocument struct {
+	XMLName xml.Name  `xml:"gexf"`
+	Xmlns   string    `xml:"xmlns,attr"`
+	Version string    `xml:"version,attr"`
+	Graph   gexfGraph `xml:"graph"`
+}
+
+type gexfGraph struct {
+	Mode            string           `xml:"mode,attr"`
+	DefaultEdgeType string           `xml:"defaultedgetype,attr"`
+	Attributes      []gexfAttributes `xml:"attributes"`
+	Nodes           []gexfNode       `xml:"nodes>node"`
+	Edges           []gexfEdge       `xml:"edges>edge"`
+}
+
+type gexfAttributes struct {
+	Class      string          `xml:"class,attr"`
+	Attributes []gexfAttribute `xml:"attribute"`
+}
+
+type gexfAttribute struct {
+	ID    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type gexfNode struct {
+	ID     string         `xml:"id,attr"`
+	Label  string         `xml:"label,attr"`
+	Values *gexfAttValues `xml:"attvalues,omitempty"`
+}
+
+type gexfEdge struct {
+	ID     string         `xml:"id,attr"`
+	Source string         `xml:"source,attr"`
+	Target string         `xml:"target,attr"`
+	Label  string         `xml:"label,attr"`
+	Values *gexfAttValues `xml:"attvalues,omitempty"`
+}
+
+type gexfAttValues struct {
+	Values []gexfAttValue `xml:"attvalue"`
+}
+
+type gexfAttValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// gexfValues lists the values of the declared attributes held by props, nil if none.
+func gexfValues(attrs []attribute, props map[string]interface{}, values ...gexfAttValue) *gexfAttValues {
+	for _, a := range attrs {
+		if v := props[a.name]; v != nil {
+			values = append(values, gexfAttValue{For: a.id, Value: attributeValue(v)})
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return &gexfAttValues{Values: values}
+}
+
+func gexfDeclarations(class string, attrs []attribute) gexfAttributes {
+	decl := gexfAttributes{Class: class}
+	for _, a := range attrs {
+		decl.Attributes = append(decl.Attributes, gexfAttribute{ID: a.id, Title: a.title, Type: a.typ})
+	}
+	return decl
+}
+
+// writeGEXF writes the entities as a static, directed GEXF 1.3 graph.
+// Nodes are captioned by their labels, formatted as A:B, and edges by their
+// relationship type. Labels are declared as the "labels" node attribute as well.
+func writeGEXF(w io.Writer, s *entitySet) error {
+	nodeAttrs := append([]attribute{{id: "labels", name: "labels", title: "labels", typ: "string"}},
+		declareAttributes("d", nodeProperties(s.nodes), "labels")...)
+	edgeAttrs := declareAttributes("k", edgeProperties(s.edges))
+
+	doc := gexfDocument{
+		Xmlns:   "http://gexf.net/1.3",
+		Version: "1.3",
+		Graph: gexfGraph{
+			Mode:            "static",
+			DefaultEdgeType: "directed",
+			Attributes: []gexfAttributes{
+				gexfDeclarations("node", nodeAttrs),
+				gexfDeclarations("edge", edgeAttrs),
+			},
+		},
+	}
+
+	for _, n := range s.nodes {
+		labels := strings.Join(n.Labels, ":")
+		var values []gexfAttValue
+		if labels != "" {
+			values = append(values, gexfAttValue{For: "labels", Value: labels})
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, gexfNode{
+			ID:     strconv.FormatUint(n.ID, 10),
+			Label:  labels,
+			Values: gexfValues(nodeAttrs[1:], n.Properties, values...),
+		})
+	}
+	for _, e := range s.edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, gexfEdge{
+			ID:     strconv.FormatUint(e.ID, 10),
+			Source: strconv.FormatUint(e.SourceNodeID(), 10),
+			Target: strconv.FormatUint(e.DestNodeID(), 10),
+			Label:  e.Relation,
+			Values: gexfValues(edgeAttrs, e.Properties),
+		})
+	}
+
+	return writeXML(w, doc)
+}
@@ -1,6 +1,8 @@
 package falkordb
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,7 +11,8 @@ import (
 
 // QueryOptions are a set of additional arguments to be emitted with a query.
 type QueryOptions struct {
-	timeout int
+	timeout          int
+	resolveEndpoints bool
 }
 
 // Graph represents a graph, which is a collection of nodes and edges.
@@ -43,6 +46,30 @@ func (g *Graph) Delete() error {
 	return err
 }
 
+// DeleteWithStats removes the graph, returning the statistics of the removal,
+// whose GraphRemovedInternalExecutionTime is set.
+func (g *Graph) DeleteWithStats() (Stats, error) {
+	r, err := g.Conn.Do(ctx, "GRAPH.DELETE", g.Id).Result()
+
+	// clear internal mappings
+	g.schema.clear()
+
+	if err != nil {
+		return Stats{}, err
+	}
+	return deleteStats(r)
+}
+
+// deleteStats parses the GRAPH.DELETE reply, a single statistic line.
+func deleteStats(reply interface{}) (Stats, error) {
+	qr := &QueryResult{}
+	err := qr.parseStatistics([]interface{}{reply})
+	if err != nil {
+		return Stats{}, err
+	}
+	return qr.Stats(), nil
+}
+
 // NewQueryOptions instantiates a new QueryOptions struct.
 func NewQueryOptions() *QueryOptions {
 	return &QueryOptions{
@@ -61,22 +88,61 @@ func (options *QueryOptions) GetTimeout() int {
 	return options.timeout
 }
 
-func (g *Graph) query(command string, query string, params map[string]interface{}, options *QueryOptions) (*QueryResult, error) {
+// SetResolveEndpoints sets whether the Source and Destination of the edges
+// returned by the query are fetched, see QueryResult.ResolveEndpoints.
+// Pipelined queries ignore it.
+func (options *QueryOptions) SetResolveEndpoints(resolve bool) *QueryOptions {
+	options.resolveEndpoints = resolve
+	return options
+}
+
+// GetResolveEndpoints retrieves whether the endpoints of the edges returned
+// by the query are fetched.
+func (options *QueryOptions) GetResolveEndpoints() bool {
+	return options.resolveEndpoints
+}
+
+// queryArgs builds the command arguments of a query.
+func (g *Graph) queryArgs(command string, query string, params map[string]interface{}, options *QueryOptions) ([]interface{}, error) {
 	if params != nil {
-		query = BuildParamsHeader(params) + query
+		header, err := buildParamsHeader(params)
+		if err != nil {
+			return nil, err
+		}
+		query = header + query
 	}
-	var r interface{}
-	var err error
+	args := []interface{}{command, g.Id, query, "--compact"}
 	if options != nil && options.timeout >= 0 {
-		r, err = g.Conn.Do(ctx, command, g.Id, query, "--compact", "timeout", options.timeout).Result()
-	} else {
-		r, err = g.Conn.Do(ctx, command, g.Id, query, "--compact").Result()
+		args = append(args, "timeout", options.timeout)
 	}
+	return args, nil
+}
+
+func (g *Graph) query(command string, query string, params map[string]interface{}, options *QueryOptions) (*QueryResult, error) {
+	return g.queryContext(ctx, command, query, params, options)
+}
+
+func (g *Graph) queryContext(c context.Context, command string, query string, params map[string]interface{}, options *QueryOptions) (*QueryResult, error) {
+	args, err := g.queryArgs(command, query, params, options)
+	if err != nil {
+		return nil, err
+	}
+	r, err := g.Conn.Do(c, args...).Result()
 	if err != nil {
 		return nil, err
 	}
 
-	return QueryResultNew(g, r)
+	qr, err := QueryResultNew(g, r)
+	if err != nil {
+		return nil, err
+	}
+	if options != nil && options.resolveEndpoints {
+		err = qr.ResolveEndpoints(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return qr, nil
 }
 
 // Query executes a query against the graph.
@@ -91,19 +157,75 @@ func (g *Graph) ROQuery(query string, params map[string]interface{}, options *Qu
 
 // Procedures
 
-// CallProcedure invokes procedure.
-func (g *Graph) CallProcedure(procedure string, yield []string, args ...interface{}) (*QueryResult, error) {
-	query := fmt.Sprintf("CALL %s(", procedure)
+// ProcedureCall describes the invocation of a procedure.
+type ProcedureCall struct {
+	// Name is the procedure's name, such as db.labels.
+	Name string
+	// Args are passed to the procedure as query parameters.
+	Args []interface{}
+	// Yield lists the outputs of the procedure returned, the procedure's
+	// default outputs when empty.
+	Yield []string
+	// ReadOnly runs the call as a read only query.
+	ReadOnly bool
+	// Options are emitted with the query, may be nil.
+	Options *QueryOptions
+}
+
+// isProcedureName reports whether name is made of dot separated identifiers.
+func isProcedureName(name string) bool {
+	for _, part := range strings.Split(name, ".") {
+		if !isIdentifier(part) {
+			return false
+		}
+	}
+	return true
+}
 
-	tmp := make([]string, 0, len(args))
-	for arg := range args {
-		tmp = append(tmp, ToString(arg))
+// query builds the query invoking the procedure, along with its parameters.
+func (call ProcedureCall) query() (string, map[string]interface{}, error) {
+	if !isProcedureName(call.Name) {
+		return "", nil, fmt.Errorf("invalid procedure name %q", call.Name)
 	}
-	query += fmt.Sprintf("%s)", strings.Join(tmp, ","))
 
-	if len(yield) > 0 {
-		query += fmt.Sprintf(" YIELD %s", strings.Join(yield, ","))
+	var params map[string]interface{}
+	args := make([]string, len(call.Args))
+	if len(call.Args) > 0 {
+		params = make(map[string]interface{}, len(call.Args))
+	}
+	for i, arg := range call.Args {
+		name := fmt.Sprintf("arg%d", i)
+		params[name] = arg
+		args[i] = "$" + name
+	}
+	query := fmt.Sprintf("CALL %s(%s)", call.Name, strings.Join(args, ", "))
+
+	if len(call.Yield) > 0 {
+		yield := make([]string, len(call.Yield))
+		for i, y := range call.Yield {
+			if y == "" {
+				return "", nil, errors.New("empty procedure yield name")
+			}
+			yield[i] = quoteIdentifier(y)
+		}
+		query += " YIELD " + strings.Join(yield, ", ")
 	}
+	return query, params, nil
+}
 
-	return g.Query(query, nil, nil)
+// Call invokes a procedure, passing its arguments as query parameters.
+func (g *Graph) Call(call ProcedureCall) (*QueryResult, error) {
+	query, params, err := call.query()
+	if err != nil {
+		return nil, err
+	}
+	if call.ReadOnly {
+		return g.ROQuery(query, params, call.Options)
+	}
+	return g.Query(query, params, call.Options)
+}
+
+// CallProcedure invokes procedure, see Call.
+func (g *Graph) CallProcedure(procedure string, yield []string, args ...interface{}) (*QueryResult, error) {
+	return g.Call(ProcedureCall{Name: procedure, Args: args, Yield: yield})
 }
@@ -26,8 +26,18 @@ func (gs *GraphSchema) clear() {
 	gs.properties = []string{}
 }
 
+// errNoConnection is returned when the schema of a detached graph needs refreshing.
+var errNoConnection = errors.New("graph has no connection to refresh its schema")
+
+func (gs *GraphSchema) connected() bool {
+	return gs.graph != nil && gs.graph.Conn != nil
+}
+
 func (gs *GraphSchema) refresh_labels() error {
-	qr, err := gs.graph.CallProcedure("db.labels", nil)
+	if !gs.connected() {
+		return errNoConnection
+	}
+	qr, err := gs.graph.Call(ProcedureCall{Name: "db.labels", ReadOnly: true})
 	if err != nil {
 		return err
 	}
@@ -40,13 +50,19 @@ func (gs *GraphSchema) refresh_labels() error {
 			return err
 		}
 
-		gs.labels[idx] = label.(string)
+		gs.labels[idx], err = replyString(label, "label")
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func (gs *GraphSchema) refresh_relationships() error {
-	qr, err := gs.graph.CallProcedure("db.relationshipTypes", nil)
+	if !gs.connected() {
+		return errNoConnection
+	}
+	qr, err := gs.graph.Call(ProcedureCall{Name: "db.relationshipTypes", ReadOnly: true})
 	if err != nil {
 		return err
 	}
@@ -58,13 +74,19 @@ func (gs *GraphSchema) refresh_relationships() error {
 		if err != nil {
 			return err
 		}
-		gs.relationships[idx] = relationship.(string)
+		gs.relationships[idx], err = replyString(relationship, "relationship type")
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func (gs *GraphSchema) refresh_properties() error {
-	qr, err := gs.graph.CallProcedure("db.propertyKeys", nil)
+	if !gs.connected() {
+		return errNoConnection
+	}
+	qr, err := gs.graph.Call(ProcedureCall{Name: "db.propertyKeys", ReadOnly: true})
 	if err != nil {
 		return err
 	}
@@ -76,12 +98,18 @@ func (gs *GraphSchema) refresh_properties() error {
 		if err != nil {
 			return err
 		}
-		gs.properties[idx] = property.(string)
+		gs.properties[idx], err = replyString(property, "property key")
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func (gs *GraphSchema) getLabel(lblIdx int) (string, error) {
+	if lblIdx < 0 {
+		return "", errors.New("Unknown label index.")
+	}
 	if lblIdx >= len(gs.labels) {
 		err := gs.refresh_labels()
 		if err != nil {
@@ -97,13 +125,16 @@ func (gs *GraphSchema) getLabel(lblIdx int) (string, error) {
 }
 
 func (gs *GraphSchema) getRelation(relIdx int) (string, error) {
+	if relIdx < 0 {
+		return "", errors.New("Unknown relationship index.")
+	}
 	if relIdx >= len(gs.relationships) {
 		err := gs.refresh_relationships()
 		if err != nil {
 			return "", err
 		}
 		if relIdx >= len(gs.relationships) {
-			return "", errors.New("Unknown label index.")
+			return "", errors.New("Unknown relationship index.")
 		}
 	}
 
@@ -111,6 +142,9 @@ func (gs *GraphSchema) getRelation(relIdx int) (string, error) {
 }
 
 func (gs *GraphSchema) getProperty(propIdx int) (string, error) {
+	if propIdx < 0 {
+		return "", errors.New("Unknown property index.")
+	}
 	if propIdx >= len(gs.properties) {
 		err := gs.refresh_properties()
 		if err != nil {
@@ -123,3 +157,18 @@ func (gs *GraphSchema) getProperty(propIdx int) (string, error) {
 
 	return gs.properties[propIdx], nil
 }
+
+// Label returns the name of label lblIdx, refreshing the schema if lblIdx is unknown.
+func (gs *GraphSchema) Label(lblIdx int) (string, error) {
+	return gs.getLabel(lblIdx)
+}
+
+// Relation returns the name of relationship type relIdx, refreshing the schema if relIdx is unknown.
+func (gs *GraphSchema) Relation(relIdx int) (string, error) {
+	return gs.getRelation(relIdx)
+}
+
+// Property returns the name of property key propIdx, refreshing the schema if propIdx is unknown.
+func (gs *GraphSchema) Property(propIdx int) (string, error) {
+	return gs.getProperty(propIdx)
+}
@@ -0,0 +1,51 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcedureCallQuery(t *testing.T) {
+	q, params, err := ProcedureCall{Name: "db.labels"}.query()
+	assert.NoError(t, err)
+	assert.Equal(t, "CALL db.labels()", q)
+	assert.Nil(t, params)
+
+	q, params, err = ProcedureCall{
+		Name:  "algo.pageRank",
+		Args:  []interface{}{"it's", nil},
+		Yield: []string{"node", "odd`name"},
+	}.query()
+	assert.NoError(t, err)
+	assert.Equal(t, "CALL algo.pageRank($arg0, $arg1) YIELD `node`, `odd``name`", q)
+	assert.Equal(t, map[string]interface{}{"arg0": "it's", "arg1": nil}, params)
+
+	for _, name := range []string{"", "db.", "db labels", "db.labels() MATCH (n) DELETE n //"} {
+		_, _, err = ProcedureCall{Name: name}.query()
+		assert.Error(t, err, name)
+	}
+	_, _, err = ProcedureCall{Name: "db.labels", Yield: []string{""}}.query()
+	assert.Error(t, err)
+
+	_, params, err = ProcedureCall{Name: "algo.BFS", Args: []interface{}{int32(1), []uint64{2}}}.query()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), params["arg0"])
+	_, err = (&Graph{Id: "detached"}).Call(ProcedureCall{Name: "db.labels", Args: []interface{}{struct{}{}}})
+	assert.EqualError(t, err, `parameter "arg0": unsupported parameter type struct {}`)
+}
+
+func TestQueryArgs(t *testing.T) {
+	g := &Graph{Id: "g"}
+	args, err := g.queryArgs("GRAPH.RO_QUERY", "RETURN $x", map[string]interface{}{"x": int32(1)}, NewQueryOptions().SetTimeout(5))
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"GRAPH.RO_QUERY", "g", "CYPHER x=1 RETURN $x", "--compact", "timeout", 5}, args)
+
+	_, err = g.queryArgs("GRAPH.QUERY", "RETURN $x", map[string]interface{}{"x": uint64(1 << 63)}, nil)
+	assert.EqualError(t, err, `parameter "x": integer 9223372036854775808 overflows a 64 bit signed integer`)
+	_, err = g.Query("RETURN $x", map[string]interface{}{"x": struct{}{}}, nil)
+	assert.EqualError(t, err, `parameter "x": unsupported parameter type struct {}`)
+	assert.PanicsWithValue(t, "Unrecognized type to convert to string", func() {
+		BuildParamsHeader(map[string]interface{}{"x": struct{}{}})
+	})
+}
@@ -0,0 +1,119 @@
+package falkordb
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// graphMLValues lists the values of the declared attributes held by props.
+func graphMLValues(attrs []attribute, props map[string]interface{}) []graphMLData {
+	var data []graphMLData
+	for _, a := range attrs {
+		if v := props[a.name]; v != nil {
+			data = append(data, graphMLData{Key: a.id, Value: attributeValue(v)})
+		}
+	}
+	return data
+}
+
+// writeGraphML writes the entities as a directed GraphML graph.
+// Node labels are declared as the "labels" attribute, formatted as :A:B,
+// relationship types as the "relation" attribute.
+// Property keys are identified by d0, d1... for nodes and k0, k1... for
+// edges, apart from the node and edge ids n<ID> and e<ID>.
+func writeGraphML(w io.Writer, s *entitySet) error {
+	nodeAttrs := declareAttributes("d", nodeProperties(s.nodes), "labels")
+	edgeAttrs := declareAttributes("k", edgeProperties(s.edges), "relation")
+
+	doc := graphMLDocument{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "labels", For: "node", Name: "labels", Type: "string"},
+			{ID: "relation", For: "edge", Name: "relation", Type: "string"},
+		},
+		Graph: graphMLGraph{ID: "G", EdgeDefault: "directed"},
+	}
+	for _, a := range nodeAttrs {
+		doc.Keys = append(doc.Keys, graphMLKey{ID: a.id, For: "node", Name: a.title, Type: a.typ})
+	}
+	for _, a := range edgeAttrs {
+		doc.Keys = append(doc.Keys, graphMLKey{ID: a.id, For: "edge", Name: a.title, Type: a.typ})
+	}
+
+	for _, n := range s.nodes {
+		data := []graphMLData{}
+		if len(n.Labels) > 0 {
+			data = append(data, graphMLData{Key: "labels", Value: ":" + strings.Join(n.Labels, ":")})
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID:   "n" + strconv.FormatUint(n.ID, 10),
+			Data: append(data, graphMLValues(nodeAttrs, n.Properties)...),
+		})
+	}
+	for _, e := range s.edges {
+		data := []graphMLData{{Key: "relation", Value: e.Relation}}
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			ID:     "e" + strconv.FormatUint(e.ID, 10),
+			Source: "n" + strconv.FormatUint(e.SourceNodeID(), 10),
+			Target: "n" + strconv.FormatUint(e.DestNodeID(), 10),
+			Data:   append(data, graphMLValues(edgeAttrs, e.Properties)...),
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+func writeXML(w io.Writer, doc interface{}) error {
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	err = enc.Encode(doc)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
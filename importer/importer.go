@@ -0,0 +1,503 @@
+// Package importer loads nodes and edges described by CSV files into a graph.
+//
+// Node files hold one node per row, identified by an id column and labeled
+// either by a fixed label or by a label column. Edge files hold one edge per
+// row, connecting the nodes whose ids appear in their source and destination
+// columns. All other columns become properties, whose types are either inferred
+// from the values or declared per column.
+//
+// Rows are sent in batches of parameterized UNWIND queries. Rows which can't be
+// converted, and edges whose source or destination node doesn't exist, are
+// skipped and reported, along with their file and line, in the returned Report.
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/FalkorDB/falkordb-go/v2"
+	"github.com/FalkorDB/falkordb-go/v2/internal/cypher"
+)
+
+const (
+	defaultBatchSize = 1000
+	defaultIDColumn  = "id"
+)
+
+// NodeFile describes a CSV file of nodes.
+// The first row of the file holds the column names.
+type NodeFile struct {
+	// Name identifies the file in errors and progress reports.
+	Name   string
+	Reader io.Reader
+	// Label labels every node of the file, LabelColumn names the column
+	// holding the label of each node when Label is empty.
+	Label       string
+	LabelColumn string
+	// IDColumn names the column identifying the nodes, "id" by default.
+	// The id is stored as a property of the same name, which edges refer to.
+	IDColumn string
+	// Types declares the type of columns, other columns are inferred.
+	Types map[string]PropertyType
+}
+
+// EdgeFile describes a CSV file of edges.
+// The first row of the file holds the column names.
+type EdgeFile struct {
+	// Name identifies the file in errors and progress reports.
+	Name   string
+	Reader io.Reader
+	// Type is the relationship type of every edge of the file, TypeColumn names
+	// the column holding the type of each edge when Type is empty, "type" by default.
+	Type       string
+	TypeColumn string
+	// SourceColumn and DestinationColumn name the columns holding the ids
+	// of the connected nodes, "src" and "dst" by default.
+	SourceColumn      string
+	DestinationColumn string
+	// SourceLabel and DestinationLabel restrict the nodes the ids are matched against.
+	// Matching unlabeled endpoints scans every node of the graph.
+	SourceLabel      string
+	DestinationLabel string
+	// Key names the node property the ids are matched against, "id" by default.
+	Key string
+	// Types declares the type of columns, other columns are inferred.
+	Types map[string]PropertyType
+}
+
+// Progress describes the state of an import after a batch was loaded.
+type Progress struct {
+	// File is the name of the file being imported.
+	File string
+	// Rows is the number of rows of File read so far.
+	Rows int
+	// Stats aggregates the statistics of all the batches loaded so far.
+	Stats falkordb.Stats
+}
+
+// Options control how files are imported.
+type Options struct {
+	batchSize int
+	progress  func(Progress)
+}
+
+// NewOptions instantiates a new Options struct.
+func NewOptions() *Options {
+	return &Options{batchSize: defaultBatchSize}
+}
+
+// SetBatchSize sets the maximum number of rows sent in a single query.
+func (options *Options) SetBatchSize(batchSize int) *Options {
+	options.batchSize = batchSize
+	return options
+}
+
+// GetBatchSize retrieves the maximum number of rows sent in a single query.
+func (options *Options) GetBatchSize() int {
+	return options.batchSize
+}
+
+// SetProgress sets a function called after every batch is loaded.
+func (options *Options) SetProgress(progress func(Progress)) *Options {
+	options.progress = progress
+	return options
+}
+
+// RowError reports a row which was skipped, or an edge row whose
+// endpoints weren't found.
+type RowError struct {
+	File string
+	Line int
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	return fmt.Sprintf("%s:%d: %v", e.File, e.Line, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Report summarizes an import.
+type Report struct {
+	// Rows is the number of rows read, including skipped rows.
+	Rows   int
+	Stats  falkordb.Stats
+	Errors []*RowError
+}
+
+// Import loads nodes and then edges into g.
+// An error is returned if a file can't be read or a batch fails to load,
+// in which case the report covers the batches loaded before the failure.
+func Import(g *falkordb.Graph, nodes []NodeFile, edges []EdgeFile, options *Options) (*Report, error) {
+	if options == nil {
+		options = NewOptions()
+	}
+	bulk := falkordb.NewBulkOptions().SetBatchSize(options.batchSize)
+	l := newLoader(graphSink{graph: g, options: bulk}, options)
+	for _, f := range nodes {
+		err := l.nodes(f)
+		if err != nil {
+			return l.report, err
+		}
+	}
+	for _, f := range edges {
+		err := l.edges(f)
+		if err != nil {
+			return l.report, err
+		}
+	}
+	return l.report, nil
+}
+
+// ImportNodes loads a file of nodes into g.
+func ImportNodes(g *falkordb.Graph, file NodeFile, options *Options) (*Report, error) {
+	return Import(g, []NodeFile{file}, nil, options)
+}
+
+// ImportEdges loads a file of edges into g.
+func ImportEdges(g *falkordb.Graph, file EdgeFile, options *Options) (*Report, error) {
+	return Import(g, nil, []EdgeFile{file}, options)
+}
+
+// errMissingEndpoint reports an edge row whose endpoints weren't found.
+var errMissingEndpoint = errors.New("source or destination node not found")
+
+// sink receives the batches of an import.
+type sink interface {
+	createNodes(label string, rows []map[string]interface{}) (falkordb.Stats, error)
+	createEdges(relation string, src falkordb.EdgeEndpoint, dst falkordb.EdgeEndpoint, rows []falkordb.BulkEdge) (falkordb.Stats, error)
+	// unmatchedEdges returns the indexes of the rows whose source or
+	// destination node doesn't exist, in order.
+	unmatchedEdges(src falkordb.EdgeEndpoint, dst falkordb.EdgeEndpoint, rows []falkordb.BulkEdge) ([]int, error)
+}
+
+type graphSink struct {
+	graph   *falkordb.Graph
+	options *falkordb.BulkOptions
+}
+
+func (s graphSink) createNodes(label string, rows []map[string]interface{}) (falkordb.Stats, error) {
+	return s.graph.BulkCreateNodes(label, rows, s.options)
+}
+
+func (s graphSink) createEdges(relation string, src falkordb.EdgeEndpoint, dst falkordb.EdgeEndpoint, rows []falkordb.BulkEdge) (falkordb.Stats, error) {
+	return s.graph.BulkCreateEdges(relation, src, dst, rows, s.options)
+}
+
+func (s graphSink) unmatchedEdges(src falkordb.EdgeEndpoint, dst falkordb.EdgeEndpoint, rows []falkordb.BulkEdge) ([]int, error) {
+	endpoints := make([]interface{}, len(rows))
+	for i, r := range rows {
+		endpoints[i] = map[string]interface{}{"src": r.Source, "dst": r.Destination}
+	}
+	q := fmt.Sprintf("UNWIND range(0, size($rows) - 1) AS i WITH i, $rows[i] AS row "+
+		"OPTIONAL MATCH (s%s) WITH i, row, count(s) AS sources "+
+		"OPTIONAL MATCH (d%s) WITH i, sources, count(d) AS destinations "+
+		"WHERE sources = 0 OR destinations = 0 RETURN i ORDER BY i",
+		endpointPattern(src, "row.src"), endpointPattern(dst, "row.dst"))
+	qr, err := s.graph.ROQuery(q, map[string]interface{}{"rows": endpoints}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var unmatched []int
+	for qr.Next() {
+		i, ok := qr.Record().Values()[0].(int64)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected a row index, got %T", falkordb.ErrMalformedReply, qr.Record().Values()[0])
+		}
+		unmatched = append(unmatched, int(i))
+	}
+	return unmatched, nil
+}
+
+// endpointPattern builds the pattern matching the node of endpoint identified by value.
+func endpointPattern(endpoint falkordb.EdgeEndpoint, value string) string {
+	label := ""
+	if endpoint.Label != "" {
+		label = ":" + cypher.QuoteIdentifier(endpoint.Label)
+	}
+	return fmt.Sprintf("%s {%s: %s}", label, cypher.QuoteIdentifier(endpoint.Key), value)
+}
+
+type loader struct {
+	sink    sink
+	options *Options
+	report  *Report
+	file    string
+	rows    int
+	// line is the line of the record being read.
+	line int
+}
+
+func newLoader(s sink, options *Options) *loader {
+	if options == nil {
+		options = NewOptions()
+	}
+	return &loader{sink: s, options: options, report: &Report{}}
+}
+
+func (l *loader) rowError(line int, err error) {
+	l.report.Errors = append(l.report.Errors, &RowError{File: l.file, Line: line, Err: err})
+}
+
+// loaded records the statistics of a batch and reports progress.
+func (l *loader) loaded(stats falkordb.Stats) {
+	l.report.Stats = l.report.Stats.Add(stats)
+	if l.options.progress != nil {
+		l.options.progress(Progress{File: l.file, Rows: l.rows, Stats: l.report.Stats})
+	}
+}
+
+// batches accumulates rows per label or relationship type,
+// preserving the order in which groups first appear.
+type batches[T any] struct {
+	order []string
+	rows  map[string][]T
+}
+
+func (b *batches[T]) add(group string, row T) int {
+	if b.rows == nil {
+		b.rows = make(map[string][]T)
+	}
+	if _, ok := b.rows[group]; !ok {
+		b.order = append(b.order, group)
+	}
+	b.rows[group] = append(b.rows[group], row)
+	return len(b.rows[group])
+}
+
+// take removes and returns the rows of group.
+func (b *batches[T]) take(group string) []T {
+	rows := b.rows[group]
+	b.rows[group] = nil
+	return rows
+}
+
+func (l *loader) nodes(f NodeFile) error {
+	idColumn := f.IDColumn
+	if idColumn == "" {
+		idColumn = defaultIDColumn
+	}
+	if f.Label == "" && f.LabelColumn == "" {
+		return fmt.Errorf("%s: either a label or a label column is required", f.Name)
+	}
+
+	var pending batches[map[string]interface{}]
+	flush := func(label string) error {
+		rows := pending.take(label)
+		if len(rows) == 0 {
+			return nil
+		}
+		stats, err := l.sink.createNodes(label, rows)
+		if err != nil {
+			return fmt.Errorf("%s: loading %s nodes: %w", l.file, label, err)
+		}
+		l.loaded(stats)
+		return nil
+	}
+
+	err := l.read(f.Name, f.Reader, []string{idColumn, f.LabelColumn}, func(columns []string, record []string) error {
+		label := f.Label
+		props := make(map[string]interface{}, len(columns))
+		for i, name := range columns {
+			if f.Label == "" && name == f.LabelColumn {
+				label = record[i]
+				continue
+			}
+			v, err := parseValue(record[i], f.Types[name])
+			if err != nil {
+				return rowErr{fmt.Errorf("column %q: %w", name, err)}
+			}
+			if v != nil {
+				props[name] = v
+			}
+		}
+		if label == "" {
+			return rowErr{errors.New("missing label")}
+		}
+		if props[idColumn] == nil {
+			return rowErr{errors.New("missing id")}
+		}
+
+		if pending.add(label, props) >= l.options.batchSize {
+			return flush(label)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, label := range pending.order {
+		err = flush(label)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *loader) edges(f EdgeFile) error {
+	srcColumn, dstColumn, typeColumn, key := f.SourceColumn, f.DestinationColumn, f.TypeColumn, f.Key
+	if srcColumn == "" {
+		srcColumn = "src"
+	}
+	if dstColumn == "" {
+		dstColumn = "dst"
+	}
+	if typeColumn == "" && f.Type == "" {
+		typeColumn = "type"
+	}
+	if key == "" {
+		key = defaultIDColumn
+	}
+	src := falkordb.EdgeEndpoint{Label: f.SourceLabel, Key: key}
+	dst := falkordb.EdgeEndpoint{Label: f.DestinationLabel, Key: key}
+
+	// rows are kept along with their line, to report missing endpoints
+	type edgeRow struct {
+		edge falkordb.BulkEdge
+		line int
+	}
+	var pending batches[edgeRow]
+	flush := func(relation string) error {
+		rows := pending.take(relation)
+		if len(rows) == 0 {
+			return nil
+		}
+		edges := make([]falkordb.BulkEdge, len(rows))
+		for i, r := range rows {
+			edges[i] = r.edge
+		}
+		stats, err := l.sink.createEdges(relation, src, dst, edges)
+		if err != nil {
+			return fmt.Errorf("%s: loading %s edges: %w", l.file, relation, err)
+		}
+		l.loaded(stats)
+
+		// rows whose endpoints weren't matched create no edge
+		if stats.RelationshipsCreated < len(rows) {
+			unmatched, err := l.sink.unmatchedEdges(src, dst, edges)
+			if err != nil {
+				return fmt.Errorf("%s: checking %s edge endpoints: %w", l.file, relation, err)
+			}
+			for _, i := range unmatched {
+				l.rowError(rows[i].line, errMissingEndpoint)
+			}
+		}
+		return nil
+	}
+
+	required := []string{srcColumn, dstColumn}
+	if f.Type == "" {
+		required = append(required, typeColumn)
+	}
+	err := l.read(f.Name, f.Reader, required, func(columns []string, record []string) error {
+		e := falkordb.BulkEdge{Properties: make(map[string]interface{}, len(columns))}
+		relation := f.Type
+		for i, name := range columns {
+			if f.Type == "" && name == typeColumn {
+				relation = record[i]
+				continue
+			}
+			v, err := parseValue(record[i], f.Types[name])
+			if err != nil {
+				return rowErr{fmt.Errorf("column %q: %w", name, err)}
+			}
+			switch {
+			case name == srcColumn:
+				e.Source = v
+			case name == dstColumn:
+				e.Destination = v
+			case v != nil:
+				e.Properties[name] = v
+			}
+		}
+		if relation == "" {
+			return rowErr{errors.New("missing relationship type")}
+		}
+		if e.Source == nil || e.Destination == nil {
+			return rowErr{errors.New("missing endpoint id")}
+		}
+
+		if pending.add(relation, edgeRow{edge: e, line: l.line}) >= l.options.batchSize {
+			return flush(relation)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, relation := range pending.order {
+		err = flush(relation)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rowErr wraps the errors which only skip the current row.
+type rowErr struct {
+	err error
+}
+
+func (e rowErr) Error() string {
+	return e.err.Error()
+}
+
+// read calls row for every record of the CSV document read from r, after
+// checking its header holds the required columns. A rowErr returned by row
+// skips the record, any other error aborts the import.
+func (l *loader) read(name string, r io.Reader, required []string, row func(columns []string, record []string) error) error {
+	l.file = name
+	l.rows = 0
+
+	reader := csv.NewReader(r)
+	columns, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("%s: reading header: %w", name, err)
+	}
+	seen := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		if seen[c] {
+			return fmt.Errorf("%s: duplicate column %q", name, c)
+		}
+		seen[c] = true
+	}
+	for _, c := range required {
+		if c != "" && !seen[c] {
+			return fmt.Errorf("%s: missing column %q", name, c)
+		}
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			l.rows++
+			l.report.Rows++
+			l.rowError(parseErr.StartLine, parseErr.Err)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		l.rows++
+		l.report.Rows++
+		l.line, _ = reader.FieldPos(0)
+		err = row(columns, record)
+		var skip rowErr
+		if errors.As(err, &skip) {
+			l.rowError(l.line, skip.err)
+		} else if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package importer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/FalkorDB/falkordb-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type nodeBatch struct {
+	label string
+	rows  []map[string]interface{}
+}
+
+type edgeBatch struct {
+	relation string
+	src, dst falkordb.EdgeEndpoint
+	rows     []falkordb.BulkEdge
+}
+
+type recordingSink struct {
+	nodes []nodeBatch
+	edges []edgeBatch
+	fail  error
+	// missing holds the ids of the nodes which don't exist
+	missing map[interface{}]bool
+}
+
+func (s *recordingSink) createNodes(label string, rows []map[string]interface{}) (falkordb.Stats, error) {
+	if s.fail != nil {
+		return falkordb.Stats{}, s.fail
+	}
+	s.nodes = append(s.nodes, nodeBatch{label, rows})
+	return falkordb.Stats{NodesCreated: len(rows)}, nil
+}
+
+func (s *recordingSink) createEdges(relation string, src falkordb.EdgeEndpoint, dst falkordb.EdgeEndpoint, rows []falkordb.BulkEdge) (falkordb.Stats, error) {
+	s.edges = append(s.edges, edgeBatch{relation, src, dst, rows})
+	unmatched, _ := s.unmatchedEdges(src, dst, rows)
+	return falkordb.Stats{RelationshipsCreated: len(rows) - len(unmatched)}, nil
+}
+
+func (s *recordingSink) unmatchedEdges(src falkordb.EdgeEndpoint, dst falkordb.EdgeEndpoint, rows []falkordb.BulkEdge) ([]int, error) {
+	var unmatched []int
+	for i, r := range rows {
+		if s.missing[r.Source] || s.missing[r.Destination] {
+			unmatched = append(unmatched, i)
+		}
+	}
+	return unmatched, nil
+}
+
+func TestImportNodes(t *testing.T) {
+	s := &recordingSink{}
+	var progress []Progress
+	l := newLoader(s, NewOptions().SetBatchSize(2).SetProgress(func(p Progress) {
+		progress = append(progress, p)
+	}))
+
+	csv := "id,label,name,zip\n" +
+		"1,Person,alice,01234\n" +
+		"2,Person,bob,\n" +
+		"3,City,paris,75000\n" +
+		"4,Person,carol,x\n" +
+		",Person,nobody,1\n" +
+		"5,,nolabel,1\n" +
+		"6,Person\n"
+	err := l.nodes(NodeFile{Name: "people.csv", Reader: strings.NewReader(csv), LabelColumn: "label",
+		Types: map[string]PropertyType{"zip": TYPE_STRING}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []nodeBatch{
+		{"Person", []map[string]interface{}{
+			{"id": int64(1), "name": "alice", "zip": "01234"},
+			{"id": int64(2), "name": "bob", "zip": ""},
+		}},
+		{"Person", []map[string]interface{}{{"id": int64(4), "name": "carol", "zip": "x"}}},
+		{"City", []map[string]interface{}{{"id": int64(3), "name": "paris", "zip": "75000"}}},
+	}, s.nodes)
+
+	assert.Equal(t, 7, l.report.Rows)
+	assert.Equal(t, 4, l.report.Stats.NodesCreated)
+	lines := []int{}
+	for _, e := range l.report.Errors {
+		assert.Equal(t, "people.csv", e.File)
+		lines = append(lines, e.Line)
+	}
+	assert.Equal(t, []int{6, 7, 8}, lines)
+	assert.Equal(t, []int{2, 7, 7}, []int{progress[0].Rows, progress[1].Rows, progress[2].Rows})
+	assert.Equal(t, 4, progress[2].Stats.NodesCreated)
+}
+
+func TestImportEdges(t *testing.T) {
+	s := &recordingSink{}
+	l := newLoader(s, nil)
+
+	csv := "from,to,since,weight\n" +
+		"1,2,2020,0.5\n" +
+		"2,,2021,1\n" +
+		"2,3,2022,heavy\n"
+	err := l.edges(EdgeFile{Name: "knows.csv", Reader: strings.NewReader(csv), Type: "KNOWS",
+		SourceColumn: "from", DestinationColumn: "to", SourceLabel: "Person",
+		Types: map[string]PropertyType{"weight": TYPE_FLOAT}})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []edgeBatch{{
+		relation: "KNOWS",
+		src:      falkordb.EdgeEndpoint{Label: "Person", Key: "id"},
+		dst:      falkordb.EdgeEndpoint{Key: "id"},
+		rows: []falkordb.BulkEdge{{Source: int64(1), Destination: int64(2),
+			Properties: map[string]interface{}{"since": int64(2020), "weight": 0.5}}},
+	}}, s.edges)
+	assert.Len(t, l.report.Errors, 2)
+	assert.ErrorContains(t, l.report.Errors[1], `knows.csv:4: column "weight": invalid float "heavy"`)
+
+	// the type column is required unless a type is given
+	err = l.edges(EdgeFile{Name: "bad.csv", Reader: strings.NewReader("src,dst\n1,2\n")})
+	assert.ErrorContains(t, err, `missing column "type"`)
+}
+
+func TestImportMissingEndpoints(t *testing.T) {
+	s := &recordingSink{missing: map[interface{}]bool{int64(9): true}}
+	l := newLoader(s, NewOptions().SetBatchSize(2))
+
+	csv := "src,dst\n" +
+		"1,2\n" +
+		"1,9\n" +
+		"9,2\n" +
+		"2,1\n"
+	err := l.edges(EdgeFile{Name: "knows.csv", Reader: strings.NewReader(csv), Type: "KNOWS"})
+	assert.NoError(t, err)
+
+	assert.Len(t, s.edges, 2)
+	assert.Equal(t, 2, l.report.Stats.RelationshipsCreated)
+	assert.Len(t, l.report.Errors, 2)
+	for i, line := range []int{3, 4} {
+		assert.Equal(t, line, l.report.Errors[i].Line)
+		assert.ErrorIs(t, l.report.Errors[i], errMissingEndpoint)
+	}
+}
+
+func TestEndpointPattern(t *testing.T) {
+	assert.Equal(t, ":`Person` {`id`: row.src}", endpointPattern(falkordb.EdgeEndpoint{Label: "Person", Key: "id"}, "row.src"))
+	assert.Equal(t, " {`a b`: row.dst}", endpointPattern(falkordb.EdgeEndpoint{Key: "a b"}, "row.dst"))
+}
+
+func TestImportFailure(t *testing.T) {
+	failure := errors.New("boom")
+	s := &recordingSink{fail: failure}
+	l := newLoader(s, nil)
+	err := l.nodes(NodeFile{Name: "n.csv", Reader: strings.NewReader("id\n1\n"), Label: "N"})
+	assert.ErrorIs(t, err, failure)
+
+	err = l.nodes(NodeFile{Name: "n.csv", Reader: strings.NewReader("id,id\n1,1\n"), Label: "N"})
+	assert.ErrorContains(t, err, "duplicate column")
+	err = l.nodes(NodeFile{Name: "n.csv", Reader: strings.NewReader("id\n1\n")})
+	assert.Error(t, err)
+}
+
+func TestParseValue(t *testing.T) {
+	values := []struct {
+		s        string
+		typ      PropertyType
+		expected interface{}
+	}{
+		{"", TYPE_INFERRED, nil},
+		{"", TYPE_STRING, ""},
+		// inference rules are covered by the tests of internal/infer
+		{"[1, 'a']", TYPE_INFERRED, []interface{}{int64(1), "a"}},
+		{"7", TYPE_FLOAT, 7.0},
+		{" 7 ", TYPE_INTEGER, int64(7)},
+		{"false", TYPE_BOOLEAN, false},
+	}
+	for _, v := range values {
+		parsed, err := parseValue(v.s, v.typ)
+		assert.NoError(t, err)
+		assert.Equal(t, v.expected, parsed, "%q as %v", v.s, v.typ)
+	}
+	_, err := parseValue("1.5", TYPE_INTEGER)
+	assert.Error(t, err)
+	_, err = parseValue("yes", TYPE_BOOLEAN)
+	assert.Error(t, err)
+}
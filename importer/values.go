@@ -0,0 +1,82 @@
+package importer
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/FalkorDB/falkordb-go/v2/internal/infer"
+)
+
+// PropertyType is the type a column's values are converted to.
+type PropertyType int
+
+const (
+	// TYPE_INFERRED converts values to integers, floats, booleans or
+	// arrays when they read as such, and keeps them as strings otherwise,
+	// following the inference of the bulk loader.
+	TYPE_INFERRED PropertyType = iota
+	TYPE_STRING
+	TYPE_INTEGER
+	TYPE_FLOAT
+	TYPE_BOOLEAN
+)
+
+var propertyTypeNames = map[PropertyType]string{
+	TYPE_INFERRED: "inferred",
+	TYPE_STRING:   "string",
+	TYPE_INTEGER:  "integer",
+	TYPE_FLOAT:    "float",
+	TYPE_BOOLEAN:  "boolean",
+}
+
+func (t PropertyType) String() string {
+	if name, ok := propertyTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("PropertyType(%d)", int(t))
+}
+
+// parseValue converts s to typ. Empty values are null, unless typ is TYPE_STRING.
+func parseValue(s string, typ PropertyType) (interface{}, error) {
+	if s == "" && typ != TYPE_STRING {
+		return nil, nil
+	}
+
+	switch typ {
+	case TYPE_INFERRED:
+		return infer.Value(s), nil
+	case TYPE_STRING:
+		return s, nil
+	case TYPE_INTEGER:
+		i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", s)
+		}
+		return i, nil
+	case TYPE_FLOAT:
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("invalid float %q", s)
+		}
+		return f, nil
+	case TYPE_BOOLEAN:
+		b, ok := parseBool(strings.TrimSpace(s))
+		if !ok {
+			return nil, fmt.Errorf("invalid boolean %q", s)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("unknown property type %v", typ)
+}
+
+func parseBool(s string) (bool, bool) {
+	switch strings.ToLower(s) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+	return false, false
+}
@@ -0,0 +1,11 @@
+// Package cypher holds the helpers building queries which are shared by
+// the packages of the client.
+package cypher
+
+import "strings"
+
+// QuoteIdentifier escapes name for use as a label, relationship type
+// or attribute name within a query.
+func QuoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
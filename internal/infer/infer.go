@@ -0,0 +1,78 @@
+// Package infer infers the type of the textual values read from CSV files,
+// shared by the bulk loader and the importer.
+package infer
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Value converts a textual value, as read from a CSV file, into the
+// type it represents. Empty values are null, integers are int64, other
+// finite numbers float64 and true or false, in any case, booleans.
+// Values enclosed in brackets are arrays, whose comma separated elements
+// are inferred in turn once stripped of their enclosing quotes.
+// Other values are kept as strings.
+func Value(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+	switch strings.ToLower(s) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if len(s) >= 2 && s[0] == '[' && s[len(s)-1] == ']' {
+		elems := splitArrayLiteral(s[1 : len(s)-1])
+		arr := make([]interface{}, len(elems))
+		for i, e := range elems {
+			arr[i] = Value(unquote(e))
+		}
+		return arr
+	}
+	return s
+}
+
+// splitArrayLiteral splits the elements of an array literal on its top level commas.
+func splitArrayLiteral(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	var elems []string
+	depth := 0
+	var quote rune
+	start := 0
+	for i, c := range s {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			elems = append(elems, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+	return append(elems, strings.TrimSpace(s[start:]))
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,18 @@
+package infer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValue(t *testing.T) {
+	assert.Nil(t, Value(""))
+	assert.Equal(t, int64(-4), Value("-4"))
+	assert.Equal(t, 1.5, Value("1.5"))
+	assert.Equal(t, true, Value("True"))
+	assert.Equal(t, "nan", Value("nan"))
+	assert.Equal(t, []interface{}{int64(1), "a, b", []interface{}{2.5}}, Value("[1, 'a, b', [2.5]]"))
+	assert.Equal(t, []interface{}{}, Value("[]"))
+	assert.Equal(t, "[unclosed", Value("[unclosed"))
+}
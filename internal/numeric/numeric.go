@@ -0,0 +1,14 @@
+// Package numeric converts the numeric values returned by queries.
+package numeric
+
+// Float64 converts v, either an integer or a float depending on the
+// computation producing it, to a float. It reports false for any other value.
+func Float64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
@@ -0,0 +1,18 @@
+package numeric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat64(t *testing.T) {
+	f, ok := Float64(int64(3))
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, f)
+	f, ok = Float64(2.5)
+	assert.True(t, ok)
+	assert.Equal(t, 2.5, f)
+	_, ok = Float64("x")
+	assert.False(t, ok)
+}
@@ -0,0 +1,275 @@
+package falkordb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Values are encoded to JSON as their native counterpart when one exists.
+// Others are encoded as a single key object tagging their type:
+//
+//	{"$point": {"latitude": 1.5, "longitude": 2.0}}
+//	{"$datetime": "2024-03-15T10:20:30Z"}
+//	{"$date": "2024-03-15"}
+//	{"$time": "10:20:30"}
+//	{"$duration": "1m30s"}
+//	{"$vecf32": [0.5, 1]}
+//	{"$node": {"id": 1, "labels": ["Person"], "properties": {}}}
+//	{"$edge": {"id": 2, "relation": "KNOWS", "source": 1, "destination": 3, "properties": {}}}
+//	{"$path": {"nodes": [...], "edges": [...]}}
+//
+// Maps which would read as a tagged value are wrapped in {"$map": {...}}.
+// Floats always carry a decimal point or an exponent so they are told apart
+// from integers when decoded.
+
+// jsonFloat encodes a float so it reads back as one.
+type jsonFloat float64
+
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	s := strconv.FormatFloat(float64(f), 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return []byte(s), nil
+}
+
+// toJSONValue converts v into a value encoding/json marshals as described above.
+func toJSONValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil, string, bool, int, int64:
+		return v, nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("cannot encode %v to JSON", v)
+		}
+		return jsonFloat(v), nil
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for i, e := range v {
+			j, err := toJSONValue(e)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = j
+		}
+		return arr, nil
+	case map[string]interface{}:
+		m, err := toJSONMap(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(v) == 1 {
+			for k := range v {
+				if strings.HasPrefix(k, "$") {
+					return map[string]interface{}{"$map": m}, nil
+				}
+			}
+		}
+		return m, nil
+	case Point:
+		return map[string]interface{}{"$point": map[string]interface{}{
+			"latitude":  jsonFloat(v.Latitude),
+			"longitude": jsonFloat(v.Longitude),
+		}}, nil
+	case time.Time:
+		return map[string]interface{}{"$datetime": v.UTC().Format(time.RFC3339Nano)}, nil
+	case Date:
+		return map[string]interface{}{"$date": v.String()}, nil
+	case LocalTime:
+		return map[string]interface{}{"$time": v.Format("15:04:05.999999999")}, nil
+	case time.Duration:
+		return map[string]interface{}{"$duration": v.String()}, nil
+	case []float32:
+		return map[string]interface{}{"$vecf32": vectorToJSON(v)}, nil
+	case Vector32:
+		return map[string]interface{}{"$vecf32": vectorToJSON(v)}, nil
+	case *Node:
+		return map[string]interface{}{"$node": v}, nil
+	case *Edge:
+		return map[string]interface{}{"$edge": v}, nil
+	case Path:
+		return map[string]interface{}{"$path": v}, nil
+	}
+	return nil, fmt.Errorf("cannot encode %T to JSON", v)
+}
+
+func toJSONMap(m map[string]interface{}) (map[string]interface{}, error) {
+	out := make(map[string]interface{}, len(m))
+	for k, e := range m {
+		j, err := toJSONValue(e)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", k, err)
+		}
+		out[k] = j
+	}
+	return out, nil
+}
+
+func vectorToJSON(vec []float32) []json.Number {
+	out := make([]json.Number, len(vec))
+	for i, f := range vec {
+		out[i] = json.Number(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	}
+	return out
+}
+
+// fromJSONValue converts a value unmarshaled by a decoder using UseNumber
+// back into the value toJSONValue encoded.
+func fromJSONValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil, string, bool:
+		return v, nil
+	case json.Number:
+		if strings.ContainsAny(string(v), ".eE") {
+			return v.Float64()
+		}
+		return v.Int64()
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for i, e := range v {
+			d, err := fromJSONValue(e)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = d
+		}
+		return arr, nil
+	case map[string]interface{}:
+		if len(v) == 1 {
+			for k, e := range v {
+				if strings.HasPrefix(k, "$") {
+					return fromTaggedJSON(k, e)
+				}
+			}
+		}
+		return fromJSONMap(v)
+	}
+	return nil, fmt.Errorf("unexpected JSON value %v (%T)", v, v)
+}
+
+func fromJSONMap(m map[string]interface{}) (map[string]interface{}, error) {
+	out := make(map[string]interface{}, len(m))
+	for k, e := range m {
+		d, err := fromJSONValue(e)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", k, err)
+		}
+		out[k] = d
+	}
+	return out, nil
+}
+
+func fromTaggedJSON(tag string, v interface{}) (interface{}, error) {
+	invalid := func() error {
+		return fmt.Errorf("invalid %s value %v", tag, v)
+	}
+
+	if tag == "$map" {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, invalid()
+		}
+		return fromJSONMap(m)
+	}
+
+	if tag == "$point" {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, invalid()
+		}
+		lat, ok1 := m["latitude"].(json.Number)
+		lon, ok2 := m["longitude"].(json.Number)
+		if !ok1 || !ok2 {
+			return nil, invalid()
+		}
+		var p Point
+		var err1, err2 error
+		p.Latitude, err1 = lat.Float64()
+		p.Longitude, err2 = lon.Float64()
+		if err1 != nil || err2 != nil {
+			return nil, invalid()
+		}
+		return p, nil
+	}
+
+	if tag == "$vecf32" {
+		arr, ok := v.([]interface{})
+		if !ok {
+			return nil, invalid()
+		}
+		vec := make([]float32, len(arr))
+		for i, e := range arr {
+			n, ok := e.(json.Number)
+			if !ok {
+				return nil, invalid()
+			}
+			f, err := strconv.ParseFloat(string(n), 32)
+			if err != nil {
+				return nil, invalid()
+			}
+			vec[i] = float32(f)
+		}
+		return vec, nil
+	}
+
+	if tag == "$node" || tag == "$edge" || tag == "$path" {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		switch tag {
+		case "$node":
+			n := &Node{}
+			err = n.UnmarshalJSON(b)
+			return n, err
+		case "$edge":
+			e := &Edge{}
+			err = e.UnmarshalJSON(b)
+			return e, err
+		}
+		var p Path
+		err = p.UnmarshalJSON(b)
+		return p, err
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return nil, invalid()
+	}
+	var out interface{}
+	var err error
+	switch tag {
+	case "$datetime":
+		var t time.Time
+		t, err = time.Parse(time.RFC3339Nano, s)
+		out = t.UTC()
+	case "$date":
+		var t time.Time
+		t, err = time.Parse(time.DateOnly, s)
+		out = Date{t}
+	case "$time":
+		var t time.Time
+		t, err = time.Parse("15:04:05.999999999", s)
+		out = LocalTimeOf(t)
+	case "$duration":
+		out, err = time.ParseDuration(s)
+	default:
+		return nil, fmt.Errorf("unknown JSON value tag %q", tag)
+	}
+	if err != nil {
+		return nil, invalid()
+	}
+	return out, nil
+}
+
+// unmarshalJSON decodes data into v, keeping numbers as json.Number.
+func unmarshalJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
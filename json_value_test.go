@@ -0,0 +1,110 @@
+package falkordb
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONValues(t *testing.T) {
+	values := map[string]interface{}{
+		"null":     nil,
+		"int":      int64(3),
+		"float":    2.0,
+		"small":    1e-30,
+		"string":   "s",
+		"array":    []interface{}{int64(1), 0.5, "x"},
+		"map":      map[string]interface{}{"a": int64(1)},
+		"tagged":   map[string]interface{}{"$point": "not a point"},
+		"point":    Point{Latitude: 1.5, Longitude: -2},
+		"datetime": time.Date(2024, time.March, 15, 10, 20, 30, 0, time.UTC),
+		"date":     DateOf(time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)),
+		"time":     LocalTimeOf(time.Date(2024, time.March, 15, 10, 20, 30, 0, time.UTC)),
+		"duration": 90 * time.Second,
+		"vector":   []float32{0.1, 1},
+	}
+
+	encoded, err := toJSONMap(values)
+	assert.NoError(t, err)
+	b, err := json.Marshal(encoded)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"float":2.0`)
+	assert.Contains(t, string(b), `"point":{"$point":{"latitude":1.5,"longitude":-2.0}}`)
+	assert.Contains(t, string(b), `"tagged":{"$map":{"$point":"not a point"}}`)
+	assert.Contains(t, string(b), `"vector":{"$vecf32":[0.1,1]}`)
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var raw map[string]interface{}
+	assert.NoError(t, dec.Decode(&raw))
+	decoded, err := fromJSONMap(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, values, decoded)
+
+	_, err = toJSONValue(struct{}{})
+	assert.Error(t, err)
+	_, err = fromJSONValue(map[string]interface{}{"$unknown": "x"})
+	assert.Error(t, err)
+	_, err = fromJSONValue(map[string]interface{}{"$date": "yesterday"})
+	assert.Error(t, err)
+}
+
+func TestEntityJSON(t *testing.T) {
+	n := &Node{ID: 1, Labels: []string{"Person"}, Alias: "p", Properties: map[string]interface{}{"born": DateOf(time.Date(1990, time.May, 1, 0, 0, 0, 0, time.UTC))}}
+	b, err := json.Marshal(n)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":1,"labels":["Person"],"alias":"p","properties":{"born":{"$date":"1990-05-01"}}}`, string(b))
+	var decodedNode Node
+	assert.NoError(t, json.Unmarshal(b, &decodedNode))
+	assert.Equal(t, *n, decodedNode)
+
+	e := EdgeNew("KNOWS", n, &Node{ID: 3}, map[string]interface{}{"w": 1.0})
+	e.ID = 2
+	b, err = json.Marshal(e)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":2,"relation":"KNOWS","source":1,"destination":3,"properties":{"w":1.0}}`, string(b))
+	var decodedEdge Edge
+	assert.NoError(t, json.Unmarshal(b, &decodedEdge))
+	assert.Equal(t, uint64(1), decodedEdge.SourceNodeID())
+	assert.Equal(t, uint64(3), decodedEdge.DestNodeID())
+	assert.Equal(t, 1.0, decodedEdge.GetProperty("w"))
+
+	p := Path{Nodes: []*Node{n, {ID: 3, Properties: map[string]interface{}{}}}, Edges: []*Edge{&decodedEdge}}
+	b, err = json.Marshal(p)
+	assert.NoError(t, err)
+	var decodedPath Path
+	assert.NoError(t, json.Unmarshal(b, &decodedPath))
+	assert.Equal(t, "<(1)-[2]->(3)>", decodedPath.String())
+	assert.Equal(t, *n, *decodedPath.FirstNode())
+
+	_, err = json.Marshal(&Node{Properties: map[string]interface{}{"x": struct{}{}}})
+	assert.Error(t, err)
+}
+
+func TestQueryResultJSON(t *testing.T) {
+	qr := sampleEntities(t)
+	b, err := json.Marshal(qr)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"columns":[{"name":"a","type":1,"scalar_type":8},{"name":"b","type":1,"scalar_type":6}],`+
+		`"rows":[[{"$node":{"id":0,"labels":["Person"],"properties":{"age":3,"name":"a \u0026 b"}}},`+
+		`[{"$edge":{"id":1,"relation":"Visited","source":0,"destination":1,"properties":{"year":2017}}},`+
+		`{"$node":{"id":0,"labels":["Person"],"properties":{"age":3,"name":"a \u0026 b"}}}]],`+
+		`[{"$node":{"id":2,"labels":[],"properties":{"age":0.5}}},null]],`+
+		`"stats":{"Nodes created":1,"Query internal execution time":0.5}}`, string(b))
+
+	var decoded QueryResult
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, qr.Columns(), decoded.Columns())
+	assert.Equal(t, qr.Stats(), decoded.Stats())
+	assert.Equal(t, len(qr.results), len(decoded.results))
+	for i := range qr.results {
+		assert.Equal(t, qr.results[i].values, decoded.results[i].values)
+	}
+
+	assert.True(t, decoded.Next())
+	assert.Equal(t, []string{"a", "b"}, decoded.Record().Keys())
+	assert.Error(t, json.Unmarshal([]byte(`{"columns":[{"name":"a"}],"rows":[[1,2]]}`), &decoded))
+}
@@ -0,0 +1,127 @@
+package falkordb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NeighborOptions control the expansion of a node's neighborhood.
+type NeighborOptions struct {
+	// RelTypes restricts the relationship types followed, all when empty.
+	RelTypes []string
+	// Direction is the direction edges are followed in, outgoing by default.
+	Direction Direction
+	// MinDepth is the minimal number of hops to a neighbor, 1 when unset.
+	MinDepth int
+	// MaxDepth is the maximal number of hops to a neighbor, MinDepth when unset.
+	MaxDepth int
+	// Limit caps the number of paths returned, unlimited when unset.
+	Limit int
+	// LabelFilter only keeps neighbors having one of the labels, all when empty.
+	LabelFilter []string
+}
+
+// Neighborhood holds the neighbors of a node, the edges leading to them
+// and the paths connecting the node to each of them.
+type Neighborhood struct {
+	// Nodes are the distinct neighbors, in the order they were reached.
+	Nodes []*Node
+	// Edges are the distinct edges of the paths.
+	Edges []*Edge
+	// Paths are the paths from the node to its neighbors.
+	Paths []Path
+}
+
+// relationshipPattern builds a variable length relationship pattern such as
+// -[:A|B*1..2]->, following direction.
+func relationshipPattern(relTypes []string, direction Direction, minDepth int, maxDepth int) string {
+	types := make([]string, len(relTypes))
+	for i, t := range relTypes {
+		types[i] = quoteIdentifier(t)
+	}
+	rel := "["
+	if len(types) > 0 {
+		rel += ":" + strings.Join(types, "|")
+	}
+	rel += fmt.Sprintf("*%d..%d]", minDepth, maxDepth)
+
+	switch direction {
+	case DIRECTION_INCOMING:
+		return "<-" + rel + "-"
+	case DIRECTION_BOTH:
+		return "-" + rel + "-"
+	}
+	return "-" + rel + "->"
+}
+
+// neighborsQuery builds the query expanding the neighborhood of a node.
+func neighborsQuery(nodeID uint64, options NeighborOptions) (string, map[string]interface{}, error) {
+	minDepth := options.MinDepth
+	if minDepth == 0 {
+		minDepth = 1
+	}
+	maxDepth := options.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = minDepth
+	}
+	if minDepth < 0 || maxDepth < minDepth {
+		return "", nil, fmt.Errorf("invalid neighbor depth range %d..%d", minDepth, maxDepth)
+	}
+	if options.Limit < 0 {
+		return "", nil, fmt.Errorf("invalid neighbor limit %d", options.Limit)
+	}
+	if options.Direction < DIRECTION_OUTGOING || options.Direction > DIRECTION_BOTH {
+		return "", nil, fmt.Errorf("invalid direction %d", options.Direction)
+	}
+
+	params := map[string]interface{}{"id": int64(nodeID)}
+	q := fmt.Sprintf("MATCH p = (n)%s(m) WHERE ID(n) = $id",
+		relationshipPattern(options.RelTypes, options.Direction, minDepth, maxDepth))
+	if len(options.LabelFilter) > 0 {
+		labels := make([]interface{}, len(options.LabelFilter))
+		for i, l := range options.LabelFilter {
+			labels[i] = l
+		}
+		params["labels"] = labels
+		q += " AND any(l IN labels(m) WHERE l IN $labels)"
+	}
+	q += " RETURN p"
+	if options.Limit > 0 {
+		q += fmt.Sprintf(" LIMIT %d", options.Limit)
+	}
+	return q, params, nil
+}
+
+// Neighbors expands the neighborhood of the node with the given ID,
+// returning the nodes reachable within the options' depth range along with
+// the connecting edges and paths. The node itself is only part of the
+// neighbors when reached through a cycle.
+func (g *Graph) Neighbors(nodeID uint64, options NeighborOptions) (*Neighborhood, error) {
+	q, params, err := neighborsQuery(nodeID, options)
+	if err != nil {
+		return nil, err
+	}
+	qr, err := g.ROQuery(q, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nb := &Neighborhood{Paths: make([]Path, 0, len(qr.results))}
+	s := newEntitySet()
+	for _, r := range qr.results {
+		p, ok := r.values[0].(Path)
+		if !ok || len(p.Nodes) == 0 {
+			return nil, fmt.Errorf("%w: expected a path, got %T", ErrMalformedReply, r.values[0])
+		}
+		nb.Paths = append(nb.Paths, p)
+		// intermediate nodes may lie outside the depth range or
+		// the label filter, only the path's last node is a neighbor
+		s.addNode(p.Nodes[len(p.Nodes)-1])
+		for _, e := range p.Edges {
+			s.addEdge(e)
+		}
+	}
+	nb.Nodes = s.nodes
+	nb.Edges = s.edges
+	return nb, nil
+}
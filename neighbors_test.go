@@ -0,0 +1,37 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNeighborsQuery(t *testing.T) {
+	q, params, err := neighborsQuery(3, NeighborOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "MATCH p = (n)-[*1..1]->(m) WHERE ID(n) = $id RETURN p", q)
+	assert.Equal(t, map[string]interface{}{"id": int64(3)}, params)
+
+	q, params, err = neighborsQuery(3, NeighborOptions{
+		RelTypes:    []string{"KNOWS", "WORKS WITH"},
+		Direction:   DIRECTION_BOTH,
+		MinDepth:    2,
+		MaxDepth:    3,
+		Limit:       10,
+		LabelFilter: []string{"Person"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "MATCH p = (n)-[:`KNOWS`|`WORKS WITH`*2..3]-(m) WHERE ID(n) = $id AND any(l IN labels(m) WHERE l IN $labels) RETURN p LIMIT 10", q)
+	assert.Equal(t, []interface{}{"Person"}, params["labels"])
+
+	q, _, err = neighborsQuery(3, NeighborOptions{Direction: DIRECTION_INCOMING, MaxDepth: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "MATCH p = (n)<-[*1..2]-(m) WHERE ID(n) = $id RETURN p", q)
+
+	_, _, err = neighborsQuery(3, NeighborOptions{MinDepth: 3, MaxDepth: 2})
+	assert.Error(t, err)
+	_, _, err = neighborsQuery(3, NeighborOptions{Limit: -1})
+	assert.Error(t, err)
+	_, _, err = neighborsQuery(3, NeighborOptions{Direction: Direction(7)})
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package falkordb
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -82,3 +83,58 @@ func (n Node) Encode() string {
 	s = append(s, ")")
 	return strings.Join(s, "")
 }
+
+// nodeJSON is the JSON encoding of a node, shared by MarshalJSON and Export.
+type nodeJSON struct {
+	// Type is "node" within NDJSON exports, empty otherwise.
+	Type       string                 `json:"type,omitempty"`
+	ID         uint64                 `json:"id"`
+	Labels     []string               `json:"labels"`
+	Alias      string                 `json:"alias,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+func newNodeJSON(n *Node) (nodeJSON, error) {
+	props, err := toJSONMap(n.Properties)
+	if err != nil {
+		return nodeJSON{}, err
+	}
+	labels := n.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	return nodeJSON{ID: n.ID, Labels: labels, Alias: n.Alias, Properties: props}, nil
+}
+
+func (j nodeJSON) node() (*Node, error) {
+	props, err := fromJSONMap(j.Properties)
+	if err != nil {
+		return nil, err
+	}
+	return &Node{ID: j.ID, Labels: j.Labels, Alias: j.Alias, Properties: props}, nil
+}
+
+// MarshalJSON encodes the node as an object holding its id, labels, alias and properties.
+// Property values without a JSON counterpart are encoded as objects tagging their type.
+func (n Node) MarshalJSON() ([]byte, error) {
+	j, err := newNodeJSON(&n)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a node encoded by MarshalJSON.
+func (n *Node) UnmarshalJSON(data []byte) error {
+	var j nodeJSON
+	err := unmarshalJSON(data, &j)
+	if err != nil {
+		return err
+	}
+	decoded, err := j.node()
+	if err != nil {
+		return err
+	}
+	*n = *decoded
+	return nil
+}
@@ -1,6 +1,7 @@
 package falkordb
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
@@ -10,20 +11,35 @@ type Path struct {
 	Edges []*Edge
 }
 
+// PathNew builds a path of nodes and edges, panicking if they aren't
+// a *Node and an *Edge respectively, see PathFromValues.
 func PathNew(nodes []interface{}, edges []interface{}) Path {
-	Nodes := make([]*Node, len(nodes))
-	for i := 0; i < len(nodes); i++ {
-		Nodes[i] = nodes[i].(*Node)
-	}
-	Edges := make([]*Edge, len(edges))
-	for i := 0; i < len(edges); i++ {
-		Edges[i] = edges[i].(*Edge)
+	p, err := PathFromValues(nodes, edges)
+	if err != nil {
+		panic(err)
 	}
+	return p
+}
 
-	return Path{
-		Edges: Edges,
-		Nodes: Nodes,
+// PathFromValues builds a path of nodes and edges, returning an error if
+// they aren't a *Node and an *Edge respectively.
+func PathFromValues(nodes []interface{}, edges []interface{}) (Path, error) {
+	p := Path{
+		Nodes: make([]*Node, len(nodes)),
+		Edges: make([]*Edge, len(edges)),
+	}
+	var ok bool
+	for i, v := range nodes {
+		if p.Nodes[i], ok = v.(*Node); !ok {
+			return Path{}, fmt.Errorf("path node %d: expected a node, got %T", i, v)
+		}
 	}
+	for i, v := range edges {
+		if p.Edges[i], ok = v.(*Edge); !ok {
+			return Path{}, fmt.Errorf("path edge %d: expected an edge, got %T", i, v)
+		}
+	}
+	return p, nil
 }
 
 func (p Path) GetNodes() []*Node {
@@ -76,3 +92,31 @@ func (p Path) String() string {
 
 	return strings.Join(s, "")
 }
+
+type pathJSON struct {
+	Nodes []*Node `json:"nodes"`
+	Edges []*Edge `json:"edges"`
+}
+
+// MarshalJSON encodes the path as an object holding its nodes and edges.
+func (p Path) MarshalJSON() ([]byte, error) {
+	j := pathJSON{Nodes: p.Nodes, Edges: p.Edges}
+	if j.Nodes == nil {
+		j.Nodes = []*Node{}
+	}
+	if j.Edges == nil {
+		j.Edges = []*Edge{}
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a path encoded by MarshalJSON.
+func (p *Path) UnmarshalJSON(data []byte) error {
+	var j pathJSON
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	*p = Path{Nodes: j.Nodes, Edges: j.Edges}
+	return nil
+}
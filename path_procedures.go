@@ -0,0 +1,211 @@
+package falkordb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/FalkorDB/falkordb-go/v2/internal/numeric"
+)
+
+// PathOptions control the paths found by ShortestPaths and SingleSourcePaths.
+type PathOptions struct {
+	// RelTypes restricts the relationship types followed, all when empty.
+	RelTypes []string
+	// Direction is the direction edges are followed in, outgoing by default.
+	Direction Direction
+	// MaxLength caps the number of edges of a path, unlimited when unset.
+	MaxLength int
+	// WeightProperty names the edge property paths are weighted by,
+	// every edge weighing 1 when unset.
+	WeightProperty string
+	// CostProperty names the edge property paths are costed by,
+	// every edge costing 1 when unset.
+	CostProperty string
+	// MaxCost caps the cost of a path, unlimited when unset.
+	MaxCost float64
+	// PathCount is the number of paths returned, 1 when unset.
+	// ShortestPaths returns all paths of minimal weight when negative,
+	// SingleSourcePaths rejects negative counts.
+	PathCount int
+}
+
+// WeightedPath is a path found by a path procedure, along with its total
+// weight and cost.
+type WeightedPath struct {
+	Path   Path
+	Weight float64
+	Cost   float64
+}
+
+// BFSOptions control the traversal of BFS.
+type BFSOptions struct {
+	// MaxLevel caps the depth of the traversal, unlimited when unset.
+	MaxLevel int
+	// RelType restricts the relationship type followed, all when empty.
+	RelType string
+}
+
+// directionName is the name of direction within procedure configurations.
+func directionName(direction Direction) (string, error) {
+	switch direction {
+	case DIRECTION_OUTGOING:
+		return "outgoing", nil
+	case DIRECTION_INCOMING:
+		return "incoming", nil
+	case DIRECTION_BOTH:
+		return "both", nil
+	}
+	return "", fmt.Errorf("invalid direction %d", direction)
+}
+
+// pathsQuery builds the query calling the paths procedure from the node
+// with ID src, to the node with ID dst if not nil.
+func pathsQuery(procedure string, src uint64, dst *uint64, options PathOptions) (string, map[string]interface{}, error) {
+	direction, err := directionName(options.Direction)
+	if err != nil {
+		return "", nil, err
+	}
+	if options.MaxLength < 0 {
+		return "", nil, fmt.Errorf("invalid max path length %d", options.MaxLength)
+	}
+	if options.MaxCost < 0 {
+		return "", nil, fmt.Errorf("invalid max path cost %v", options.MaxCost)
+	}
+	// only algo.SPpaths reads a path count of 0 as all minimal paths
+	if options.PathCount < 0 && dst == nil {
+		return "", nil, fmt.Errorf("invalid path count %d", options.PathCount)
+	}
+
+	params := map[string]interface{}{"src": int64(src), "relDirection": direction}
+	q := "MATCH (s) WHERE ID(s) = $src "
+	config := []string{"sourceNode: s"}
+	if dst != nil {
+		params["dst"] = int64(*dst)
+		q += "MATCH (t) WHERE ID(t) = $dst "
+		config = append(config, "targetNode: t")
+	}
+	config = append(config, "relDirection: $relDirection")
+
+	if len(options.RelTypes) > 0 {
+		types := make([]interface{}, len(options.RelTypes))
+		for i, t := range options.RelTypes {
+			types[i] = t
+		}
+		params["relTypes"] = types
+		config = append(config, "relTypes: $relTypes")
+	}
+	if options.MaxLength > 0 {
+		params["maxLen"] = int64(options.MaxLength)
+		config = append(config, "maxLen: $maxLen")
+	}
+	if options.WeightProperty != "" {
+		params["weightProp"] = options.WeightProperty
+		config = append(config, "weightProp: $weightProp")
+	}
+	if options.CostProperty != "" {
+		params["costProp"] = options.CostProperty
+		config = append(config, "costProp: $costProp")
+	}
+	if options.MaxCost > 0 {
+		params["maxCost"] = options.MaxCost
+		config = append(config, "maxCost: $maxCost")
+	}
+	if options.PathCount != 0 {
+		count := options.PathCount
+		if count < 0 {
+			count = 0
+		}
+		params["pathCount"] = int64(count)
+		config = append(config, "pathCount: $pathCount")
+	}
+
+	q += fmt.Sprintf("CALL %s({%s}) YIELD path, pathWeight, pathCost RETURN path, pathWeight, pathCost",
+		procedure, strings.Join(config, ", "))
+	return q, params, nil
+}
+
+func (g *Graph) weightedPaths(q string, params map[string]interface{}) ([]WeightedPath, error) {
+	qr, err := g.ROQuery(q, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]WeightedPath, len(qr.results))
+	for i, r := range qr.results {
+		p, ok := r.values[0].(Path)
+		if !ok {
+			return nil, malformed("path", r.values[0])
+		}
+		paths[i].Path = p
+		if paths[i].Weight, ok = numeric.Float64(r.values[1]); !ok {
+			return nil, malformed("path weight", r.values[1])
+		}
+		if paths[i].Cost, ok = numeric.Float64(r.values[2]); !ok {
+			return nil, malformed("path cost", r.values[2])
+		}
+	}
+	return paths, nil
+}
+
+// ShortestPaths finds the paths of least weight between two nodes, given by
+// their IDs, using the algo.SPpaths procedure.
+// No paths are returned if either node doesn't exist.
+func (g *Graph) ShortestPaths(src uint64, dst uint64, options PathOptions) ([]WeightedPath, error) {
+	q, params, err := pathsQuery("algo.SPpaths", src, &dst, options)
+	if err != nil {
+		return nil, err
+	}
+	return g.weightedPaths(q, params)
+}
+
+// SingleSourcePaths finds the paths of least weight from a node, given by
+// its ID, to the nodes reachable from it using the algo.SSpaths procedure.
+// No paths are returned if the node doesn't exist.
+func (g *Graph) SingleSourcePaths(src uint64, options PathOptions) ([]WeightedPath, error) {
+	q, params, err := pathsQuery("algo.SSpaths", src, nil, options)
+	if err != nil {
+		return nil, err
+	}
+	return g.weightedPaths(q, params)
+}
+
+// BFS traverses the graph breadth first from a node, given by its ID,
+// using the algo.BFS procedure. It returns the nodes reached, the node
+// itself excluded, and the edges they were reached by.
+// ErrEntityNotFound is returned if the node doesn't exist.
+func (g *Graph) BFS(src uint64, options BFSOptions) ([]*Node, []*Edge, error) {
+	if options.MaxLevel < 0 {
+		return nil, nil, fmt.Errorf("invalid max BFS level %d", options.MaxLevel)
+	}
+	params := map[string]interface{}{"src": int64(src), "maxLevel": int64(options.MaxLevel), "relType": nil}
+	if options.RelType != "" {
+		params["relType"] = options.RelType
+	}
+	qr, err := g.ROQuery("MATCH (s) WHERE ID(s) = $src CALL algo.BFS(s, $maxLevel, $relType) YIELD nodes, edges RETURN nodes, edges", params, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(qr.results) == 0 {
+		return nil, nil, fmt.Errorf("node %d: %w", src, ErrEntityNotFound)
+	}
+
+	values := qr.results[0].values
+	nodeValues, ok1 := values[0].([]interface{})
+	edgeValues, ok2 := values[1].([]interface{})
+	if !ok1 || !ok2 {
+		return nil, nil, malformed("BFS nodes and edges", values)
+	}
+	nodes := make([]*Node, len(nodeValues))
+	for i, v := range nodeValues {
+		if nodes[i], ok1 = v.(*Node); !ok1 {
+			return nil, nil, malformed("BFS node", v)
+		}
+	}
+	edges := make([]*Edge, len(edgeValues))
+	for i, v := range edgeValues {
+		if edges[i], ok2 = v.(*Edge); !ok2 {
+			return nil, nil, malformed("BFS edge", v)
+		}
+	}
+	return nodes, edges, nil
+}
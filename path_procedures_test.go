@@ -0,0 +1,45 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathsQuery(t *testing.T) {
+	dst := uint64(2)
+	q, params, err := pathsQuery("algo.SPpaths", 1, &dst, PathOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "MATCH (s) WHERE ID(s) = $src MATCH (t) WHERE ID(t) = $dst "+
+		"CALL algo.SPpaths({sourceNode: s, targetNode: t, relDirection: $relDirection}) "+
+		"YIELD path, pathWeight, pathCost RETURN path, pathWeight, pathCost", q)
+	assert.Equal(t, map[string]interface{}{"src": int64(1), "dst": int64(2), "relDirection": "outgoing"}, params)
+
+	q, params, err = pathsQuery("algo.SSpaths", 1, nil, PathOptions{
+		RelTypes:       []string{"Road"},
+		Direction:      DIRECTION_BOTH,
+		MaxLength:      3,
+		WeightProperty: "km",
+		CostProperty:   "toll",
+		MaxCost:        10,
+		PathCount:      3,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "MATCH (s) WHERE ID(s) = $src "+
+		"CALL algo.SSpaths({sourceNode: s, relDirection: $relDirection, relTypes: $relTypes, maxLen: $maxLen, "+
+		"weightProp: $weightProp, costProp: $costProp, maxCost: $maxCost, pathCount: $pathCount}) "+
+		"YIELD path, pathWeight, pathCost RETURN path, pathWeight, pathCost", q)
+	assert.Equal(t, "both", params["relDirection"])
+	assert.Equal(t, []interface{}{"Road"}, params["relTypes"])
+	assert.Equal(t, int64(3), params["pathCount"])
+
+	_, _, err = pathsQuery("algo.SSpaths", 1, nil, PathOptions{MaxLength: -1})
+	assert.Error(t, err)
+	_, _, err = pathsQuery("algo.SSpaths", 1, nil, PathOptions{PathCount: -1})
+	assert.EqualError(t, err, "invalid path count -1")
+	_, params, err = pathsQuery("algo.SPpaths", 1, &dst, PathOptions{PathCount: -1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), params["pathCount"])
+	_, _, err = pathsQuery("algo.SSpaths", 1, nil, PathOptions{Direction: Direction(5)})
+	assert.Error(t, err)
+}
@@ -0,0 +1,23 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathFromValues(t *testing.T) {
+	a, b := NodeNew([]string{"A"}, "a", nil), NodeNew([]string{"B"}, "b", nil)
+	e := EdgeNew("R", a, b, nil)
+
+	p, err := PathFromValues([]interface{}{a, b}, []interface{}{e})
+	assert.NoError(t, err)
+	assert.Equal(t, Path{Nodes: []*Node{a, b}, Edges: []*Edge{e}}, p)
+	assert.Equal(t, p, PathNew([]interface{}{a, b}, []interface{}{e}))
+
+	_, err = PathFromValues([]interface{}{a, "b"}, []interface{}{e})
+	assert.EqualError(t, err, "path node 1: expected a node, got string")
+	_, err = PathFromValues([]interface{}{a, b}, []interface{}{nil})
+	assert.EqualError(t, err, "path edge 0: expected an edge, got <nil>")
+	assert.Panics(t, func() { PathNew([]interface{}{int64(1)}, nil) })
+}
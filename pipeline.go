@@ -0,0 +1,148 @@
+package falkordb
+
+import (
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Pipeline queues queries against a graph and sends them in a single round trip.
+// A Pipeline is not safe for concurrent use.
+type Pipeline struct {
+	graph *Graph
+	pipe  redis.Pipeliner
+	cmds  []*redis.Cmd
+}
+
+// PipelineError reports the queries of a pipeline which failed.
+type PipelineError struct {
+	// Errors holds the error of every queued query by position,
+	// nil for the queries which succeeded.
+	Errors []error
+}
+
+func (e *PipelineError) Error() string {
+	failed := e.Unwrap()
+	if len(failed) == 0 {
+		return "pipeline failed"
+	}
+	return fmt.Sprintf("%d of %d pipelined queries failed, first error: %v", len(failed), len(e.Errors), failed[0])
+}
+
+// Unwrap returns the errors of the failed queries.
+func (e *PipelineError) Unwrap() []error {
+	failed := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+	return failed
+}
+
+// Pipeline creates a new pipeline of queries against the graph.
+func (g *Graph) Pipeline() *Pipeline {
+	return &Pipeline{
+		graph: g,
+		pipe:  g.Conn.Pipeline(),
+	}
+}
+
+// Query queues a query.
+// Queries whose parameters can't be encoded aren't sent, their error
+// being reported by Exec.
+func (p *Pipeline) Query(query string, params map[string]interface{}, options *QueryOptions) {
+	p.queue("GRAPH.QUERY", query, params, options)
+}
+
+// ROQuery queues a read only query, see Query.
+func (p *Pipeline) ROQuery(query string, params map[string]interface{}, options *QueryOptions) {
+	p.queue("GRAPH.RO_QUERY", query, params, options)
+}
+
+func (p *Pipeline) queue(command string, query string, params map[string]interface{}, options *QueryOptions) {
+	args, err := p.graph.queryArgs(command, query, params, options)
+	if err != nil {
+		cmd := redis.NewCmd(ctx)
+		cmd.SetErr(err)
+		p.cmds = append(p.cmds, cmd)
+		return
+	}
+	p.cmds = append(p.cmds, p.pipe.Do(ctx, args...))
+}
+
+// Len returns the number of queued queries.
+func (p *Pipeline) Len() int {
+	return len(p.cmds)
+}
+
+// Exec sends all queued queries and returns their results in order.
+// If any query fails its result is nil and a *PipelineError holding
+// the per-query errors is returned alongside the other results.
+// The pipeline is emptied and can be reused.
+func (p *Pipeline) Exec() ([]*QueryResult, error) {
+	cmds := p.cmds
+	p.cmds = nil
+	if len(cmds) == 0 {
+		return nil, nil
+	}
+
+	// per command errors are inspected below
+	_, _ = p.pipe.Exec(ctx)
+
+	// all queries ran before decoding starts,
+	// so the schema needs refreshing at most once for the whole batch.
+	schema := &batchSchema{schema: &p.graph.schema}
+
+	results := make([]*QueryResult, len(cmds))
+	errs := make([]error, len(cmds))
+	failed := false
+	for i, cmd := range cmds {
+		r, err := cmd.Result()
+		if err == nil {
+			results[i], err = decodeQueryResult(p.graph, schema, r)
+		}
+		if err != nil {
+			errs[i] = err
+			failed = true
+		}
+	}
+
+	if failed {
+		return results, &PipelineError{Errors: errs}
+	}
+	return results, nil
+}
+
+// batchSchema resolves the schema ids of a batch of replies,
+// refreshing each part of the graph schema at most once.
+type batchSchema struct {
+	schema              *GraphSchema
+	labelsRefreshed     bool
+	relationsRefreshed  bool
+	propertiesRefreshed bool
+}
+
+func (b *batchSchema) Label(idx int) (string, error) {
+	if idx >= len(b.schema.labels) && !b.labelsRefreshed {
+		b.labelsRefreshed = true
+		return b.schema.getLabel(idx)
+	}
+	return staticLookup(b.schema.labels, idx, "label")
+}
+
+func (b *batchSchema) Relation(idx int) (string, error) {
+	if idx >= len(b.schema.relationships) && !b.relationsRefreshed {
+		b.relationsRefreshed = true
+		return b.schema.getRelation(idx)
+	}
+	return staticLookup(b.schema.relationships, idx, "relationship")
+}
+
+func (b *batchSchema) Property(idx int) (string, error) {
+	if idx >= len(b.schema.properties) && !b.propertiesRefreshed {
+		b.propertiesRefreshed = true
+		return b.schema.getProperty(idx)
+	}
+	return staticLookup(b.schema.properties, idx, "property")
+}
@@ -0,0 +1,49 @@
+package falkordb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchSchema_RefreshesOnce(t *testing.T) {
+	g := detachedGraph()
+	schema := &batchSchema{schema: &g.schema}
+
+	label, err := schema.Label(1)
+	assert.NoError(t, err)
+	assert.Equal(t, "Country", label)
+
+	_, err = schema.Label(5)
+	assert.ErrorIs(t, err, errNoConnection)
+	_, err = schema.Label(5)
+	assert.EqualError(t, err, "unknown label index 5")
+
+	_, err = schema.Property(3)
+	assert.ErrorIs(t, err, errNoConnection)
+	_, err = schema.Relation(1)
+	assert.ErrorIs(t, err, errNoConnection)
+}
+
+func TestPipelineError(t *testing.T) {
+	first := errors.New("first")
+	err := &PipelineError{Errors: []error{nil, first, nil, errors.New("second")}}
+	assert.ErrorIs(t, err, first)
+	assert.EqualError(t, err, "2 of 4 pipelined queries failed, first error: first")
+}
+
+func TestPipelineParameterError(t *testing.T) {
+	conn := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1"})
+	defer conn.Close()
+	p := graphNew("g", conn).Pipeline()
+	p.Query("RETURN $x", map[string]interface{}{"x": struct{}{}}, nil)
+	assert.Equal(t, 1, p.Len())
+
+	results, err := p.Exec()
+	var pipelineErr *PipelineError
+	assert.ErrorAs(t, err, &pipelineErr)
+	assert.EqualError(t, pipelineErr.Errors[0], `parameter "x": unsupported parameter type struct {}`)
+	assert.Nil(t, results[0])
+}
@@ -0,0 +1,74 @@
+package falkordb
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// mean earth radius in meters.
+const earthRadius = 6371008.8
+
+// Point represents a geographic location in WGS-84 degrees.
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Returns a string representation of point
+func (p Point) String() string {
+	return fmt.Sprintf("(%s, %s)",
+		strconv.FormatFloat(p.Latitude, 'f', -1, 64),
+		strconv.FormatFloat(p.Longitude, 'f', -1, 64))
+}
+
+// Distance returns the great-circle distance in meters between p and other.
+func (p Point) Distance(other Point) float64 {
+	lat1 := p.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (other.Longitude - p.Longitude) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+func pointToString(p Point) (string, error) {
+	if checkFinite(p.Latitude) != nil || checkFinite(p.Longitude) != nil {
+		return "", fmt.Errorf("point coordinates %v, %v aren't finite", p.Latitude, p.Longitude)
+	}
+	return fmt.Sprintf("point({latitude: %s, longitude: %s})",
+		strconv.FormatFloat(p.Latitude, 'f', -1, 64),
+		strconv.FormatFloat(p.Longitude, 'f', -1, 64)), nil
+}
+
+// NodesWithinDistance returns the nodes labeled label whose point attribute attr
+// lies within meters of center, closest first.
+func (g *Graph) NodesWithinDistance(label string, attr string, center Point, meters float64) ([]*Node, error) {
+	q := fmt.Sprintf("MATCH (n:%s) WITH n, distance(n.%s, $center) AS d WHERE d <= $meters RETURN n ORDER BY d",
+		quoteIdentifier(label), quoteIdentifier(attr))
+	params := map[string]interface{}{
+		"center": center,
+		"meters": meters,
+	}
+
+	qr, err := g.ROQuery(q, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*Node, 0, len(qr.results))
+	for qr.Next() {
+		v, err := qr.Record().GetByIndex(0)
+		if err != nil {
+			return nil, err
+		}
+		n, ok := v.(*Node)
+		if !ok {
+			return nil, fmt.Errorf("expected node, got %T", v)
+		}
+		nodes = append(nodes, n)
+	}
+	return nodes, nil
+}
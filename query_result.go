@@ -1,27 +1,30 @@
 package falkordb
 
 import (
-	"errors"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
-
-	"github.com/olekukonko/tablewriter"
-	"github.com/olekukonko/tablewriter/tw"
+	"time"
 )
 
 const (
-	LABELS_ADDED            string = "Labels added"
-	NODES_CREATED           string = "Nodes created"
-	NODES_DELETED           string = "Nodes deleted"
-	RELATIONSHIPS_DELETED   string = "Relationships deleted"
-	PROPERTIES_SET          string = "Properties set"
-	RELATIONSHIPS_CREATED   string = "Relationships created"
-	INDICES_CREATED         string = "Indices created"
-	INDICES_DELETED         string = "Indices deleted"
-	INTERNAL_EXECUTION_TIME string = "Query internal execution time"
-	CACHED_EXECUTION        string = "Cached execution"
+	LABELS_ADDED                          string = "Labels added"
+	LABELS_REMOVED                        string = "Labels removed"
+	NODES_CREATED                         string = "Nodes created"
+	NODES_DELETED                         string = "Nodes deleted"
+	RELATIONSHIPS_DELETED                 string = "Relationships deleted"
+	PROPERTIES_SET                        string = "Properties set"
+	PROPERTIES_REMOVED                    string = "Properties removed"
+	RELATIONSHIPS_CREATED                 string = "Relationships created"
+	INDICES_CREATED                       string = "Indices created"
+	INDICES_DELETED                       string = "Indices deleted"
+	CONSTRAINTS_CREATED                   string = "Constraints created"
+	CONSTRAINTS_DELETED                   string = "Constraints deleted"
+	INTERNAL_EXECUTION_TIME               string = "Query internal execution time"
+	GRAPH_REMOVED_INTERNAL_EXECUTION_TIME string = "Graph removed, internal execution time"
+	CACHED_EXECUTION                      string = "Cached execution"
 )
 
 type ResultSetColumnTypes int
@@ -49,41 +52,82 @@ const (
 	VALUE_MAP
 	VALUE_POINT
 	VALUE_VECTORF32
+	VALUE_DATETIME
+	VALUE_DATE
+	VALUE_TIME
+	VALUE_DURATION
 )
 
 type QueryResultHeader struct {
-	column_names []string
-	column_types []ResultSetColumnTypes
+	column_names        []string
+	column_types        []ResultSetColumnTypes
+	column_scalar_types []ResultSetScalarTypes
+	column_mixed        []bool
+}
+
+// Column describes a single column of a result set.
+type Column struct {
+	Name string               `json:"name"`
+	Type ResultSetColumnTypes `json:"type"`
+	// ScalarType is the type shared by all non-null values of the column.
+	// It is VALUE_NULL if every value is null and VALUE_UNKNOWN if the
+	// result set is empty or the column mixes values of different types.
+	ScalarType ResultSetScalarTypes `json:"scalar_type"`
 }
 
 // QueryResult represents the results of a query.
 type QueryResult struct {
 	graph            *Graph
+	schema           SchemaProvider
 	header           QueryResultHeader
 	results          []*Record
 	statistics       map[string]float64
 	currentRecordIdx int
 }
 
+// QueryResultNew decodes the reply of a query against g.
+// g may be nil, in which case replies referencing labels, relationship
+// types or property keys fail to decode; use a Decoder to provide them.
 func QueryResultNew(g *Graph, response interface{}) (*QueryResult, error) {
+	if g == nil {
+		return decodeQueryResult(nil, StaticSchema{}, response)
+	}
+	return decodeQueryResult(g, &g.schema, response)
+}
+
+func decodeQueryResult(g *Graph, schema SchemaProvider, response interface{}) (*QueryResult, error) {
 	qr := &QueryResult{
 		results:    nil,
 		statistics: nil,
 		header: QueryResultHeader{
-			column_names: make([]string, 0),
-			column_types: make([]ResultSetColumnTypes, 0),
+			column_names:        make([]string, 0),
+			column_types:        make([]ResultSetColumnTypes, 0),
+			column_scalar_types: make([]ResultSetScalarTypes, 0),
+			column_mixed:        make([]bool, 0),
 		},
 		graph:            g,
+		schema:           schema,
 		currentRecordIdx: -1,
 	}
 
-	r := response.([]interface{})
+	r, err := replyArray(response, "result set")
+	if err != nil {
+		return nil, err
+	}
 
-	if len(r) == 1 {
-		qr.parseStatistics(r[0])
-	} else {
-		qr.parseResults(r)
-		qr.parseStatistics(r[2])
+	switch len(r) {
+	case 1:
+		err = qr.parseStatistics(r[0])
+	case 3:
+		err = qr.parseResults(r)
+		if err == nil {
+			err = qr.parseStatistics(r[2])
+		}
+	default:
+		err = malformed("result set of 1 or 3 elements", response)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return qr, nil
@@ -93,67 +137,221 @@ func (qr *QueryResult) Empty() bool {
 	return len(qr.results) == 0
 }
 
-func (qr *QueryResult) parseResults(raw_result_set []interface{}) {
+// Columns returns the columns of the result set, available even when
+// the result set holds no records.
+func (qr *QueryResult) Columns() []Column {
+	columns := make([]Column, len(qr.header.column_names))
+	for i, name := range qr.header.column_names {
+		columns[i] = Column{
+			Name:       name,
+			Type:       qr.header.column_types[i],
+			ScalarType: qr.header.column_scalar_types[i],
+		}
+	}
+	return columns
+}
+
+type queryResultJSON struct {
+	Columns []Column           `json:"columns"`
+	Rows    [][]interface{}    `json:"rows"`
+	Stats   map[string]float64 `json:"stats"`
+}
+
+// MarshalJSON encodes the result as an object holding its columns, its rows,
+// as arrays of values, and its statistics, keyed by their server side name.
+// Nodes, edges, paths and values without a JSON counterpart are encoded as
+// objects tagging their type, such as {"$node": {...}}.
+func (qr *QueryResult) MarshalJSON() ([]byte, error) {
+	j := queryResultJSON{
+		Columns: qr.Columns(),
+		Rows:    make([][]interface{}, len(qr.results)),
+		Stats:   qr.Statistics(),
+	}
+	for i, r := range qr.results {
+		row := make([]interface{}, len(r.values))
+		for c, v := range r.values {
+			enc, err := toJSONValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("record %d, column %q: %w", i, r.keys[c], err)
+			}
+			row[c] = enc
+		}
+		j.Rows[i] = row
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a result encoded by MarshalJSON.
+// The decoded result isn't attached to a graph.
+func (qr *QueryResult) UnmarshalJSON(data []byte) error {
+	var j queryResultJSON
+	err := unmarshalJSON(data, &j)
+	if err != nil {
+		return err
+	}
+
+	decoded := QueryResult{
+		header: QueryResultHeader{
+			column_names:        make([]string, len(j.Columns)),
+			column_types:        make([]ResultSetColumnTypes, len(j.Columns)),
+			column_scalar_types: make([]ResultSetScalarTypes, len(j.Columns)),
+			column_mixed:        make([]bool, len(j.Columns)),
+		},
+		results:          make([]*Record, len(j.Rows)),
+		statistics:       j.Stats,
+		currentRecordIdx: -1,
+	}
+	for i, c := range j.Columns {
+		decoded.header.column_names[i] = c.Name
+		decoded.header.column_types[i] = c.Type
+		decoded.header.column_scalar_types[i] = c.ScalarType
+	}
+	for i, row := range j.Rows {
+		if len(row) != len(j.Columns) {
+			return fmt.Errorf("record %d holds %d values, expected %d", i, len(row), len(j.Columns))
+		}
+		values := make([]interface{}, len(row))
+		for c, v := range row {
+			values[c], err = fromJSONValue(v)
+			if err != nil {
+				return fmt.Errorf("record %d, column %q: %w", i, j.Columns[c].Name, err)
+			}
+		}
+		decoded.results[i] = recordNew(values, decoded.header.column_names)
+	}
+
+	*qr = decoded
+	return nil
+}
+
+func (qr *QueryResult) parseResults(raw_result_set []interface{}) error {
 	header := raw_result_set[0]
-	qr.parseHeader(header)
-	qr.parseRecords(raw_result_set)
+	err := qr.parseHeader(header)
+	if err != nil {
+		return err
+	}
+	return qr.parseRecords(raw_result_set)
 }
 
-func (qr *QueryResult) parseStatistics(raw_statistics interface{}) {
-	statistics := raw_statistics.([]interface{})
+func (qr *QueryResult) parseStatistics(raw_statistics interface{}) error {
+	statistics, err := replyArray(raw_statistics, "statistics")
+	if err != nil {
+		return err
+	}
 	qr.statistics = make(map[string]float64)
 
 	for _, rs := range statistics {
-		v := strings.Split(rs.(string), ": ")
-		f, _ := strconv.ParseFloat(strings.Split(v[1], " ")[0], 64)
-		qr.statistics[v[0]] = f
+		// <name>: <value>[ <unit>]
+		line, err := replyString(rs, "statistic")
+		if err != nil {
+			return err
+		}
+		name, value, ok := strings.Cut(line, ": ")
+		if !ok {
+			return malformed("statistic of the form 'name: value'", line)
+		}
+		value, _, _ = strings.Cut(value, " ")
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%w: statistic %q: %v", ErrMalformedReply, name, err)
+		}
+		qr.statistics[name] = f
 	}
+	return nil
 }
 
-func (qr *QueryResult) parseHeader(raw_header interface{}) {
-	header := raw_header.([]interface{})
+func (qr *QueryResult) parseHeader(raw_header interface{}) error {
+	header, err := replyArray(raw_header, "header")
+	if err != nil {
+		return err
+	}
 
 	for _, col := range header {
-		c := col.([]interface{})
-		ct := c[0].(int64)
-		cn := c[1].(string)
+		// [column type, column name]
+		c, err := replyTuple(col, 2, "header column")
+		if err != nil {
+			return err
+		}
+		ct, err := replyInt(c[0], "column type")
+		if err != nil {
+			return err
+		}
+		cn, err := replyString(c[1], "column name")
+		if err != nil {
+			return err
+		}
 
 		qr.header.column_types = append(qr.header.column_types, ResultSetColumnTypes(ct))
 		qr.header.column_names = append(qr.header.column_names, cn)
+		qr.header.column_scalar_types = append(qr.header.column_scalar_types, VALUE_UNKNOWN)
+		qr.header.column_mixed = append(qr.header.column_mixed, false)
+	}
+	return nil
+}
+
+// observeScalarType folds the type of a value found in column idx
+// into the column's inferred scalar type.
+func (qr *QueryResult) observeScalarType(idx int, t ResultSetScalarTypes) {
+	h := &qr.header
+	current := h.column_scalar_types[idx]
+	switch {
+	case h.column_mixed[idx] || t == current:
+	case t == VALUE_NULL:
+		if current == VALUE_UNKNOWN {
+			h.column_scalar_types[idx] = VALUE_NULL
+		}
+	case current == VALUE_UNKNOWN || current == VALUE_NULL:
+		h.column_scalar_types[idx] = t
+	default:
+		h.column_scalar_types[idx] = VALUE_UNKNOWN
+		h.column_mixed[idx] = true
 	}
 }
 
 func (qr *QueryResult) parseRecords(raw_result_set []interface{}) error {
-	records := raw_result_set[1].([]interface{})
+	records, err := replyArray(raw_result_set[1], "records")
+	if err != nil {
+		return err
+	}
 	qr.results = make([]*Record, len(records))
 
 	for i, r := range records {
-		cells := r.([]interface{})
+		cells, err := replyTuple(r, len(qr.header.column_types), "record")
+		if err != nil {
+			return err
+		}
 		values := make([]interface{}, len(cells))
 
 		for idx, c := range cells {
 			t := qr.header.column_types[idx]
 			switch t {
 			case COLUMN_SCALAR:
-				s, err := qr.parseScalar(c.([]interface{}))
+				cell, err := replyTuple(c, 2, "scalar")
+				if err != nil {
+					return err
+				}
+				s, err := qr.parseScalar(cell)
 				if err != nil {
 					return err
 				}
 				values[idx] = s
+				qr.observeScalarType(idx, ResultSetScalarTypes(cell[0].(int64)))
 			case COLUMN_NODE:
 				v, err := qr.parseNode(c)
 				if err != nil {
 					return err
 				}
 				values[idx] = v
+				qr.observeScalarType(idx, VALUE_NODE)
 			case COLUMN_RELATION:
 				v, err := qr.parseEdge(c)
 				if err != nil {
 					return err
 				}
 				values[idx] = v
+				qr.observeScalarType(idx, VALUE_EDGE)
 			default:
-				return errors.New("unknown column type")
+				return fmt.Errorf("%w: unknown column type %d", ErrMalformedReply, t)
 			}
 		}
 		qr.results[i] = recordNew(values, qr.header.column_names)
@@ -161,13 +359,23 @@ func (qr *QueryResult) parseRecords(raw_result_set []interface{}) error {
 	return nil
 }
 
-func (qr *QueryResult) parseProperties(props []interface{}) (map[string]interface{}, error) {
+func (qr *QueryResult) parseProperties(cell interface{}) (map[string]interface{}, error) {
 	// [[name, value type, value] X N]
-	properties := make(map[string]interface{})
+	props, err := replyArray(cell, "properties")
+	if err != nil {
+		return nil, err
+	}
+	properties := make(map[string]interface{}, len(props))
 	for _, prop := range props {
-		p := prop.([]interface{})
-		idx := p[0].(int64)
-		prop_name, err := qr.graph.schema.getProperty(int(idx))
+		p, err := replyTuple(prop, 3, "property")
+		if err != nil {
+			return nil, err
+		}
+		idx, err := replyInt(p[0], "property key")
+		if err != nil {
+			return nil, err
+		}
+		prop_name, err := qr.schema.Property(int(idx))
 		if err != nil {
 			return nil, err
 		}
@@ -186,26 +394,39 @@ func (qr *QueryResult) parseNode(cell interface{}) (*Node, error) {
 	// [label string offset (integer)],
 	// [[name, value type, value] X N]
 
-	c := cell.([]interface{})
-	id := c[0].(int64)
-	labelIds := c[1].([]interface{})
+	c, err := replyTuple(cell, 3, "node")
+	if err != nil {
+		return nil, err
+	}
+	id, err := replyID(c[0], "node ID")
+	if err != nil {
+		return nil, err
+	}
+	labelIds, err := replyArray(c[1], "node labels")
+	if err != nil {
+		return nil, err
+	}
 	labels := make([]string, len(labelIds))
 	for i := 0; i < len(labelIds); i++ {
-		label, err := qr.graph.schema.getLabel(int(labelIds[i].(int64)))
+		lblIdx, err := replyInt(labelIds[i], "label")
+		if err != nil {
+			return nil, err
+		}
+		label, err := qr.schema.Label(int(lblIdx))
 		if err != nil {
 			return nil, err
 		}
 		labels[i] = label
 	}
 
-	rawProps := c[2].([]interface{})
-	properties, err := qr.parseProperties(rawProps)
+	properties, err := qr.parseProperties(c[2])
 	if err != nil {
 		return nil, err
 	}
 
 	n := NodeNew(labels, "", properties)
-	n.ID = uint64(id)
+	n.ID = id
+	n.graph = qr.graph
 	return n, nil
 }
 
@@ -216,63 +437,129 @@ func (qr *QueryResult) parseEdge(cell interface{}) (*Edge, error) {
 	// dest node ID offset (integer),
 	// [[name, value, value type] X N]
 
-	c := cell.([]interface{})
-	id := c[0].(int64)
-	r := c[1].(int64)
-	relation, err := qr.graph.schema.getRelation(int(r))
+	c, err := replyTuple(cell, 5, "edge")
+	if err != nil {
+		return nil, err
+	}
+	id, err := replyID(c[0], "edge ID")
+	if err != nil {
+		return nil, err
+	}
+	r, err := replyInt(c[1], "relationship type")
+	if err != nil {
+		return nil, err
+	}
+	relation, err := qr.schema.Relation(int(r))
 	if err != nil {
 		return nil, err
 	}
 
-	src_node_id := c[2].(int64)
-	dest_node_id := c[3].(int64)
-	rawProps := c[4].([]interface{})
-	properties, err := qr.parseProperties(rawProps)
+	src_node_id, err := replyID(c[2], "edge source node ID")
+	if err != nil {
+		return nil, err
+	}
+	dest_node_id, err := replyID(c[3], "edge destination node ID")
+	if err != nil {
+		return nil, err
+	}
+	properties, err := qr.parseProperties(c[4])
 	if err != nil {
 		return nil, err
 	}
 	e := EdgeNew(relation, nil, nil, properties)
 
-	e.ID = uint64(id)
-	e.srcNodeID = uint64(src_node_id)
-	e.destNodeID = uint64(dest_node_id)
+	e.ID = id
+	e.srcNodeID = src_node_id
+	e.destNodeID = dest_node_id
+	e.graph = qr.graph
 	return e, nil
 }
 
 func (qr *QueryResult) parseArray(cell interface{}) ([]interface{}, error) {
-	var array = cell.([]interface{})
+	array, err := replyArray(cell, "array")
+	if err != nil {
+		return nil, err
+	}
 	var arrayLength = len(array)
+	var res = make([]interface{}, arrayLength)
 	for i := 0; i < arrayLength; i++ {
-		s, err := qr.parseScalar(array[i].([]interface{}))
+		elem, err := replyTuple(array[i], 2, "array element")
+		if err != nil {
+			return nil, err
+		}
+		s, err := qr.parseScalar(elem)
 		if err != nil {
 			return nil, err
 		}
-		array[i] = s
+		res[i] = s
 	}
-	return array, nil
+	return res, nil
 }
 
 func (qr *QueryResult) parsePath(cell interface{}) (Path, error) {
-	arrays := cell.([]interface{})
-	nodes, err := qr.parseScalar(arrays[0].([]interface{}))
+	// [[VALUE_ARRAY, nodes], [VALUE_ARRAY, edges]]
+	arrays, err := replyTuple(cell, 2, "path")
+	if err != nil {
+		return Path{}, err
+	}
+	rawNodes, err := replyTuple(arrays[0], 2, "path nodes")
+	if err != nil {
+		return Path{}, err
+	}
+	rawEdges, err := replyTuple(arrays[1], 2, "path edges")
 	if err != nil {
 		return Path{}, err
 	}
-	edges, err := qr.parseScalar(arrays[1].([]interface{}))
+	nodes, err := qr.parseScalar(rawNodes)
 	if err != nil {
 		return Path{}, err
 	}
-	return PathNew(nodes.([]interface{}), edges.([]interface{})), nil
+	edges, err := qr.parseScalar(rawEdges)
+	if err != nil {
+		return Path{}, err
+	}
+
+	nodeValues, ok := nodes.([]interface{})
+	if !ok {
+		return Path{}, malformed("array of path nodes", nodes)
+	}
+	edgeValues, ok := edges.([]interface{})
+	if !ok {
+		return Path{}, malformed("array of path edges", edges)
+	}
+	if len(nodeValues) != len(edgeValues)+1 {
+		return Path{}, fmt.Errorf("%w: path of %d nodes and %d edges", ErrMalformedReply, len(nodeValues), len(edgeValues))
+	}
+
+	p, err := PathFromValues(nodeValues, edgeValues)
+	if err != nil {
+		return Path{}, fmt.Errorf("%w: %v", ErrMalformedReply, err)
+	}
+	return p, nil
 }
 
 func (qr *QueryResult) parseMap(cell interface{}) (map[string]interface{}, error) {
-	var raw_map = cell.([]interface{})
+	// [key, [value type, value], key, [value type, value], ...]
+	raw_map, err := replyArray(cell, "map")
+	if err != nil {
+		return nil, err
+	}
 	var mapLength = len(raw_map)
-	var parsed_map = make(map[string]interface{})
+	if mapLength%2 != 0 {
+		return nil, malformed("map of key-value pairs", cell)
+	}
+	var parsed_map = make(map[string]interface{}, mapLength/2)
 
 	for i := 0; i < mapLength; i += 2 {
-		key := raw_map[i].(string)
-		s, err := qr.parseScalar(raw_map[i+1].([]interface{}))
+		key, err := replyString(raw_map[i], "map key")
+		if err != nil {
+			return nil, err
+		}
+		value, err := replyTuple(raw_map[i+1], 2, "map value")
+		if err != nil {
+			return nil, err
+		}
+		s, err := qr.parseScalar(value)
 		if err != nil {
 			return nil, err
 		}
@@ -282,44 +569,102 @@ func (qr *QueryResult) parseMap(cell interface{}) (map[string]interface{}, error
 	return parsed_map, nil
 }
 
-func (qr *QueryResult) parsePoint(cell interface{}) (map[string]interface{}, error) {
-	var parsed_point = make(map[string]interface{})
-	var array = cell.([]interface{})
-	lat, _ := strconv.ParseFloat(array[0].(string), 64)
-	parsed_point["latitude"] = lat
-	lon, _ := strconv.ParseFloat(array[1].(string), 64)
-	parsed_point["longitude"] = lon
-	return parsed_point, nil
+func (qr *QueryResult) parsePoint(cell interface{}) (Point, error) {
+	array, err := replyTuple(cell, 2, "point")
+	if err != nil {
+		return Point{}, err
+	}
+	lat, err := replyDouble(array[0], "point latitude")
+	if err != nil {
+		return Point{}, err
+	}
+	lon, err := replyDouble(array[1], "point longitude")
+	if err != nil {
+		return Point{}, err
+	}
+	return Point{Latitude: lat, Longitude: lon}, nil
 }
 
 func (qr *QueryResult) parseVectorF32(cell interface{}) ([]float32, error) {
-	var array = cell.([]interface{})
+	array, err := replyArray(cell, "vector")
+	if err != nil {
+		return nil, err
+	}
 	var arrayLength = len(array)
 	var res = make([]float32, arrayLength)
 	for i := 0; i < arrayLength; i++ {
-		res[i] = float32(array[i].(float64))
+		f, err := replyDouble(array[i], "vector element")
+		if err != nil {
+			return nil, err
+		}
+		res[i] = float32(f)
 	}
 	return res, nil
 }
 
+func (qr *QueryResult) parseDateTime(cell interface{}) (time.Time, error) {
+	s, err := temporalSeconds(cell)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(s, 0).UTC(), nil
+}
+
+func (qr *QueryResult) parseDate(cell interface{}) (Date, error) {
+	t, err := qr.parseDateTime(cell)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+func (qr *QueryResult) parseTime(cell interface{}) (LocalTime, error) {
+	t, err := qr.parseDateTime(cell)
+	if err != nil {
+		return LocalTime{}, err
+	}
+	return LocalTimeOf(t), nil
+}
+
+func (qr *QueryResult) parseDuration(cell interface{}) (time.Duration, error) {
+	s, err := temporalSeconds(cell)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(s) * time.Second, nil
+}
+
+// parseScalar decodes a [value type, value] pair.
 func (qr *QueryResult) parseScalar(cell []interface{}) (interface{}, error) {
-	t := cell[0].(int64)
+	if len(cell) != 2 {
+		return nil, malformed("scalar of 2 elements", cell)
+	}
+	t, err := replyInt(cell[0], "value type")
+	if err != nil {
+		return nil, err
+	}
 	v := cell[1]
 	switch ResultSetScalarTypes(t) {
 	case VALUE_NULL:
 		return nil, nil
 
 	case VALUE_STRING:
-		return v.(string), nil
+		return replyString(v, "string")
 
 	case VALUE_INTEGER:
-		return v.(int64), nil
+		return replyInt(v, "integer")
 
 	case VALUE_BOOLEAN:
-		return v.(string) == "true", nil
+		switch b := v.(type) {
+		case string:
+			return b == "true", nil
+		case bool:
+			return b, nil
+		}
+		return nil, malformed("boolean", v)
 
 	case VALUE_DOUBLE:
-		return strconv.ParseFloat(v.(string), 64)
+		return replyDouble(v, "double")
 
 	case VALUE_ARRAY:
 		return qr.parseArray(v)
@@ -342,11 +687,20 @@ func (qr *QueryResult) parseScalar(cell []interface{}) (interface{}, error) {
 	case VALUE_VECTORF32:
 		return qr.parseVectorF32(v)
 
-	case VALUE_UNKNOWN:
-		return nil, errors.New("unknown scalar type")
+	case VALUE_DATETIME:
+		return qr.parseDateTime(v)
+
+	case VALUE_DATE:
+		return qr.parseDate(v)
+
+	case VALUE_TIME:
+		return qr.parseTime(v)
+
+	case VALUE_DURATION:
+		return qr.parseDuration(v)
 	}
 
-	return nil, errors.New("unknown scalar type")
+	return nil, fmt.Errorf("%w: unknown scalar type %d", ErrMalformedReply, t)
 }
 
 func (qr *QueryResult) getStat(stat string) float64 {
@@ -379,42 +733,37 @@ func (qr *QueryResult) Record() *Record {
 	}
 }
 
-// PrettyPrint prints the QueryResult to stdout, pretty-like.
+// PrettyPrint prints the result as a table to the standard output.
 func (qr *QueryResult) PrettyPrint() {
 	if qr.Empty() {
 		return
 	}
 
-	table := tablewriter.NewTable(os.Stdout, tablewriter.WithHeaderAutoFormat(tw.Off))
-	table.Header(qr.header.column_names)
-	row_count := len(qr.results)
-	col_count := len(qr.header.column_names)
-	if len(qr.results) > 0 {
-		// Convert to [][]string.
-		results := make([][]string, row_count)
-		for i, record := range qr.results {
-			results[i] = make([]string, col_count)
-			for j, elem := range record.Values() {
-				results[i][j] = fmt.Sprint(elem)
-			}
-		}
-		table.Bulk(results)
-	} else {
-		table.Append([]string{"No data returned."})
-	}
-	table.Render()
+	qr.Render(os.Stdout, RENDER_TABLE)
+}
+
+// Stats returns the statistics reported for the query.
+func (qr *QueryResult) Stats() Stats {
+	return statsFromMap(qr.statistics)
+}
 
+// Statistics returns a copy of the raw statistics reported for the query, keyed by name.
+func (qr *QueryResult) Statistics() map[string]float64 {
+	statistics := make(map[string]float64, len(qr.statistics))
 	for k, v := range qr.statistics {
-		fmt.Fprintf(os.Stdout, "\n%s %f", k, v)
+		statistics[k] = v
 	}
-
-	fmt.Fprintf(os.Stdout, "\n")
+	return statistics
 }
 
 func (qr *QueryResult) LabelsAdded() int {
 	return int(qr.getStat(LABELS_ADDED))
 }
 
+func (qr *QueryResult) LabelsRemoved() int {
+	return int(qr.getStat(LABELS_REMOVED))
+}
+
 func (qr *QueryResult) NodesCreated() int {
 	return int(qr.getStat(NODES_CREATED))
 }
@@ -427,6 +776,10 @@ func (qr *QueryResult) PropertiesSet() int {
 	return int(qr.getStat(PROPERTIES_SET))
 }
 
+func (qr *QueryResult) PropertiesRemoved() int {
+	return int(qr.getStat(PROPERTIES_REMOVED))
+}
+
 func (qr *QueryResult) RelationshipsCreated() int {
 	return int(qr.getStat(RELATIONSHIPS_CREATED))
 }
@@ -443,6 +796,14 @@ func (qr *QueryResult) IndicesDeleted() int {
 	return int(qr.getStat(INDICES_DELETED))
 }
 
+func (qr *QueryResult) ConstraintsCreated() int {
+	return int(qr.getStat(CONSTRAINTS_CREATED))
+}
+
+func (qr *QueryResult) ConstraintsDeleted() int {
+	return int(qr.getStat(CONSTRAINTS_DELETED))
+}
+
 // Returns the query internal execution time in milliseconds
 func (qr *QueryResult) InternalExecutionTime() float64 {
 	return qr.getStat(INTERNAL_EXECUTION_TIME)
@@ -0,0 +1,222 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// detachedGraph returns a graph with a preloaded schema and no connection.
+func detachedGraph() *Graph {
+	g := &Graph{Id: "detached"}
+	g.schema = GraphSchemaNew(g)
+	g.schema.labels = []string{"Person", "Country"}
+	g.schema.relationships = []string{"Visited"}
+	g.schema.properties = []string{"name", "age", "year"}
+	return g
+}
+
+var sampleDecoder = NewDecoder(StaticSchema{
+	Labels:     []string{"Person", "Country"},
+	Relations:  []string{"Visited"},
+	Properties: []string{"name", "age", "year"},
+})
+
+func arr(elems ...interface{}) []interface{} {
+	return elems
+}
+
+var (
+	sampleStats = arr("Nodes created: 1", "Query internal execution time: 0.5 milliseconds")
+	sampleNode  = arr(int64(0), arr(int64(0)), arr(arr(int64(0), int64(VALUE_STRING), "x")))
+	sampleEdge  = arr(int64(1), int64(0), int64(0), int64(1), arr(arr(int64(2), int64(VALUE_INTEGER), int64(2017))))
+)
+
+func sampleReply(cell interface{}) []interface{} {
+	return arr(arr(arr(int64(COLUMN_SCALAR), "v")), arr(arr(cell)), sampleStats)
+}
+
+func TestQueryResultNew(t *testing.T) {
+	path := arr(int64(VALUE_PATH), arr(
+		arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_NODE), sampleNode), arr(int64(VALUE_NODE), sampleNode))),
+		arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_EDGE), sampleEdge))),
+	))
+	qr, err := sampleDecoder.Decode(sampleReply(path))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, qr.NodesCreated())
+	assert.Equal(t, 0.5, qr.InternalExecutionTime())
+
+	qr.Next()
+	v, err := qr.Record().GetByIndex(0)
+	assert.NoError(t, err)
+	p, ok := v.(Path)
+	assert.True(t, ok)
+	assert.Equal(t, 2, p.NodesCount())
+	assert.Equal(t, "Person", p.FirstNode().Labels[0])
+	assert.Equal(t, "Visited", p.GetEdge(0).Relation)
+	assert.Equal(t, int64(2017), p.GetEdge(0).GetProperty("year"))
+}
+
+func TestQueryResultNew_Malformed(t *testing.T) {
+	replies := map[string]interface{}{
+		"not an array":         "OK",
+		"wrong length":         arr(arr(), arr()),
+		"statistic no colon":   arr(arr("Nodes created")),
+		"statistic not number": arr(arr("Nodes created: many")),
+		"header not tuple":     arr(arr(int64(1)), arr(), sampleStats),
+		"record too short":     arr(arr(arr(int64(COLUMN_SCALAR), "a"), arr(int64(COLUMN_SCALAR), "b")), arr(arr(arr(int64(VALUE_NULL), nil))), sampleStats),
+		"scalar not tuple":     sampleReply(int64(3)),
+		"unknown scalar type":  sampleReply(arr(int64(99), nil)),
+		"bad integer":          sampleReply(arr(int64(VALUE_INTEGER), "3")),
+		"bad double":           sampleReply(arr(int64(VALUE_DOUBLE), "pi")),
+		"short node":           sampleReply(arr(int64(VALUE_NODE), arr(int64(0)))),
+		"negative node id":     sampleReply(arr(int64(VALUE_NODE), arr(int64(-1), arr(), arr()))),
+		"short edge":           sampleReply(arr(int64(VALUE_EDGE), arr(int64(0), int64(0)))),
+		"odd map":              sampleReply(arr(int64(VALUE_MAP), arr("k"))),
+		"path of scalars":      sampleReply(arr(int64(VALUE_PATH), arr(arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_INTEGER), int64(1)))), arr(int64(VALUE_ARRAY), arr())))),
+		"point of one value":   sampleReply(arr(int64(VALUE_POINT), arr("1.0"))),
+	}
+	for name, reply := range replies {
+		t.Run(name, func(t *testing.T) {
+			qr, err := sampleDecoder.Decode(reply)
+			assert.Nil(t, qr)
+			assert.ErrorIs(t, err, ErrMalformedReply)
+		})
+	}
+
+	// unknown schema ids are reported, a detached graph can't refresh its schema
+	unknown := sampleReply(arr(int64(VALUE_NODE), arr(int64(0), arr(int64(7)), arr())))
+	qr, err := sampleDecoder.Decode(unknown)
+	assert.Nil(t, qr)
+	assert.Error(t, err)
+	qr, err = QueryResultNew(detachedGraph(), unknown)
+	assert.Nil(t, qr)
+	assert.ErrorIs(t, err, errNoConnection)
+	qr, err = QueryResultNew(detachedGraph(), sampleReply(arr(int64(VALUE_NODE), arr(int64(0), arr(int64(-7)), arr()))))
+	assert.Nil(t, qr)
+	assert.Error(t, err)
+}
+
+// fuzzStrings are the strings a fuzzed reply can hold besides raw bytes.
+var fuzzStrings = []string{
+	"", "v", "x", "true", "false", "1.5", "-3", "nan",
+	"Nodes created: 1", "Query internal execution time: 0.5 milliseconds", "Cached execution: 1", "broken:",
+}
+
+// replyReader reads the values of a fuzzed reply out of raw bytes,
+// padding the data with nulls once exhausted.
+type replyReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *replyReader) next() byte {
+	if r.pos >= len(r.data) {
+		return 3
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *replyReader) value(depth int) interface{} {
+	switch r.next() % 6 {
+	case 0:
+		return int64(int16(uint16(r.next()) | uint16(r.next())<<8))
+	case 1:
+		return fuzzStrings[int(r.next())%len(fuzzStrings)]
+	case 2:
+		n := int(r.next() % 6)
+		if depth > 10 {
+			n = 0
+		}
+		a := make([]interface{}, n)
+		for i := range a {
+			a[i] = r.value(depth + 1)
+		}
+		return a
+	case 4:
+		return float64(int8(r.next())) / 4
+	case 5:
+		n := int(r.next() % 8)
+		b := make([]byte, 0, n)
+		for i := 0; i < n; i++ {
+			b = append(b, r.next())
+		}
+		return string(b)
+	}
+	return nil
+}
+
+// replyFromBytes deterministically builds a reply of arbitrary shape out of data.
+func replyFromBytes(data []byte) interface{} {
+	r := &replyReader{data: data}
+	return r.value(0)
+}
+
+// replyToBytes is the inverse of replyFromBytes, used to seed the corpus.
+func replyToBytes(v interface{}) []byte {
+	switch v := v.(type) {
+	case int64:
+		return []byte{0, byte(v), byte(v >> 8)}
+	case string:
+		for i, s := range fuzzStrings {
+			if s == v {
+				return []byte{1, byte(i)}
+			}
+		}
+		return append([]byte{5, byte(len(v))}, v...)
+	case []interface{}:
+		b := []byte{2, byte(len(v))}
+		for _, e := range v {
+			b = append(b, replyToBytes(e)...)
+		}
+		return b
+	case float64:
+		return []byte{4, byte(int8(v * 4))}
+	}
+	return []byte{3}
+}
+
+func FuzzQueryResultNew(f *testing.F) {
+	seeds := []interface{}{
+		arr(sampleStats),
+		sampleReply(arr(int64(VALUE_NULL), nil)),
+		sampleReply(arr(int64(VALUE_STRING), "x")),
+		sampleReply(arr(int64(VALUE_INTEGER), int64(5))),
+		sampleReply(arr(int64(VALUE_BOOLEAN), "true")),
+		sampleReply(arr(int64(VALUE_DOUBLE), "1.5")),
+		sampleReply(arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_INTEGER), int64(1))))),
+		sampleReply(arr(int64(VALUE_NODE), sampleNode)),
+		sampleReply(arr(int64(VALUE_EDGE), sampleEdge)),
+		sampleReply(arr(int64(VALUE_PATH), arr(
+			arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_NODE), sampleNode), arr(int64(VALUE_NODE), sampleNode))),
+			arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_EDGE), sampleEdge))),
+		))),
+		sampleReply(arr(int64(VALUE_PATH), arr(
+			arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_NODE), sampleNode), arr(int64(VALUE_INTEGER), int64(1)))),
+			arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_EDGE), sampleEdge))),
+		))),
+		sampleReply(arr(int64(VALUE_MAP), arr("x", arr(int64(VALUE_INTEGER), int64(1))))),
+		sampleReply(arr(int64(VALUE_POINT), arr("1.5", "-3"))),
+		sampleReply(arr(int64(VALUE_VECTORF32), arr(1.5, -0.25))),
+		sampleReply(arr(int64(VALUE_DATETIME), int64(100))),
+		sampleReply(arr(int64(VALUE_DURATION), int64(-3))),
+		arr(arr(arr(int64(COLUMN_NODE), "n")), arr(arr(sampleNode)), sampleStats),
+		arr(arr(arr(int64(COLUMN_RELATION), "e")), arr(arr(sampleEdge)), sampleStats),
+	}
+	for _, seed := range seeds {
+		data := replyToBytes(seed)
+		if !assert.Equal(f, seed, replyFromBytes(data)) {
+			f.FailNow()
+		}
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		qr, err := sampleDecoder.Decode(replyFromBytes(data))
+		if (qr == nil) == (err == nil) {
+			t.Fatalf("expecting either a result or an error, got %v, %v", qr, err)
+		}
+	})
+}
@@ -0,0 +1,252 @@
+package falkordb
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+)
+
+// RenderFormat is the format Render writes a result in.
+type RenderFormat int
+
+const (
+	// RENDER_TABLE writes a text table followed by the statistics.
+	RENDER_TABLE RenderFormat = iota
+	// RENDER_CSV writes the header and records as comma separated values,
+	// nulls being left empty.
+	RENDER_CSV
+	// RENDER_TSV writes the header and records as tab separated values,
+	// nulls being left empty.
+	RENDER_TSV
+	// RENDER_MARKDOWN writes a Markdown table followed by the statistics.
+	RENDER_MARKDOWN
+	// RENDER_JSON writes the result as encoded by MarshalJSON, indented.
+	RENDER_JSON
+)
+
+// Render writes the result to w in the given format.
+// Statistics are listed sorted by name. Nodes, edges and paths are written
+// in a Cypher like notation, such as (0:Person {name: "John"})-[1:KNOWS]->(2).
+func (qr *QueryResult) Render(w io.Writer, format RenderFormat) error {
+	switch format {
+	case RENDER_TABLE:
+		return qr.renderTable(w)
+	case RENDER_CSV:
+		return qr.renderSeparated(w, ',')
+	case RENDER_TSV:
+		return qr.renderSeparated(w, '\t')
+	case RENDER_MARKDOWN:
+		return qr.renderMarkdown(w)
+	case RENDER_JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(qr)
+	}
+	return fmt.Errorf("unknown render format %d", format)
+}
+
+// rows formats every value of the result, nulls as null.
+func (qr *QueryResult) rows(null string) [][]string {
+	rows := make([][]string, len(qr.results))
+	for i, r := range qr.results {
+		rows[i] = make([]string, len(r.values))
+		for j, v := range r.values {
+			if v == nil {
+				rows[i][j] = null
+			} else {
+				rows[i][j] = formatValue(v)
+			}
+		}
+	}
+	return rows
+}
+
+// sortedStatistics lists the statistics as "name: value" lines sorted by name.
+func (qr *QueryResult) sortedStatistics() []string {
+	lines := make([]string, 0, len(qr.statistics))
+	for k, v := range qr.statistics {
+		lines = append(lines, k+": "+strconv.FormatFloat(v, 'f', -1, 64))
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func (qr *QueryResult) renderTable(w io.Writer) error {
+	table := tablewriter.NewTable(w, tablewriter.WithHeaderAutoFormat(tw.Off))
+	table.Header(qr.header.column_names)
+	err := table.Bulk(qr.rows("null"))
+	if err != nil {
+		return err
+	}
+	err = table.Render()
+	if err != nil {
+		return err
+	}
+
+	for _, s := range qr.sortedStatistics() {
+		_, err = fmt.Fprintf(w, "\n%s", s)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintln(w)
+	return err
+}
+
+func (qr *QueryResult) renderSeparated(w io.Writer, comma rune) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	err := cw.Write(qr.header.column_names)
+	if err != nil {
+		return err
+	}
+	err = cw.WriteAll(qr.rows(""))
+	if err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+var markdownEscaper = strings.NewReplacer(`|`, `\|`, "\r\n", "<br>", "\n", "<br>")
+
+func (qr *QueryResult) renderMarkdown(w io.Writer) error {
+	var b strings.Builder
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for _, c := range cells {
+			b.WriteString(" " + markdownEscaper.Replace(c) + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	writeRow(qr.header.column_names)
+	b.WriteString("|")
+	for range qr.header.column_names {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+	for _, row := range qr.rows("null") {
+		writeRow(row)
+	}
+
+	if stats := qr.sortedStatistics(); len(stats) > 0 {
+		b.WriteString("\n")
+		for _, s := range stats {
+			b.WriteString("- " + s + "\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// formatValue formats a value for display, strings being written as is.
+func formatValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return formatNested(v)
+}
+
+// formatNested formats a value for display within another value, quoting strings.
+func formatNested(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		elems := make([]string, len(v))
+		for i, e := range v {
+			elems[i] = formatNested(e)
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case map[string]interface{}:
+		return formatProperties(v)
+	case *Node:
+		return formatNode(v)
+	case *Edge:
+		return fmt.Sprintf("(%d)-%s->(%d)", v.SourceNodeID(), formatEdge(v), v.DestNodeID())
+	case Path:
+		return formatPath(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case []float32:
+		return vectorLiteral(v)
+	case Vector32:
+		return vectorLiteral(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// formatProperties formats a map as {key: value, ...}, sorted by key.
+func formatProperties(m map[string]interface{}) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		name := k
+		if !isIdentifier(k) {
+			name = quoteIdentifier(k)
+		}
+		pairs[i] = name + ": " + formatNested(m[k])
+	}
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+// formatNode formats n as (id:Label {key: value}).
+func formatNode(n *Node) string {
+	s := "(" + strconv.FormatUint(n.ID, 10)
+	for _, l := range n.Labels {
+		s += ":" + l
+	}
+	if len(n.Properties) > 0 {
+		s += " " + formatProperties(n.Properties)
+	}
+	return s + ")"
+}
+
+// formatEdge formats e as [id:RELATION {key: value}].
+func formatEdge(e *Edge) string {
+	s := "[" + strconv.FormatUint(e.ID, 10) + ":" + e.Relation
+	if len(e.Properties) > 0 {
+		s += " " + formatProperties(e.Properties)
+	}
+	return s + "]"
+}
+
+// formatPath formats p as a chain of nodes and edges, each edge pointing
+// towards its destination.
+func formatPath(p Path) string {
+	var b strings.Builder
+	for i, n := range p.Nodes {
+		b.WriteString(formatNode(n))
+		if i >= len(p.Edges) {
+			break
+		}
+		e := p.Edges[i]
+		if e.SourceNodeID() == n.ID {
+			b.WriteString("-" + formatEdge(e) + "->")
+		} else {
+			b.WriteString("<-" + formatEdge(e) + "-")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,63 @@
+package falkordb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	qr := sampleEntities(t)
+
+	var buf strings.Builder
+	assert.NoError(t, qr.Render(&buf, RENDER_CSV))
+	assert.Equal(t, `a,b
+"(0:Person {age: 3, name: ""a & b""})","[(0)-[1:Visited {year: 2017}]->(1), (0:Person {age: 3, name: ""a & b""})]"
+(2 {age: 0.5}),
+`, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, qr.Render(&buf, RENDER_TSV))
+	assert.True(t, strings.HasPrefix(buf.String(), "a\tb\n"))
+
+	buf.Reset()
+	assert.NoError(t, qr.Render(&buf, RENDER_MARKDOWN))
+	assert.Equal(t, `| a | b |
+| --- | --- |
+| (0:Person {age: 3, name: "a & b"}) | [(0)-[1:Visited {year: 2017}]->(1), (0:Person {age: 3, name: "a & b"})] |
+| (2 {age: 0.5}) | null |
+
+- Nodes created: 1
+- Query internal execution time: 0.5
+`, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, qr.Render(&buf, RENDER_TABLE))
+	assert.Contains(t, buf.String(), `(0:Person {age: 3, name: "a & b"})`)
+	assert.True(t, strings.HasSuffix(buf.String(), "\nNodes created: 1\nQuery internal execution time: 0.5\n"))
+
+	buf.Reset()
+	assert.NoError(t, qr.Render(&buf, RENDER_JSON))
+	decoded := &QueryResult{}
+	assert.NoError(t, decoded.UnmarshalJSON([]byte(buf.String())))
+	assert.Equal(t, 2, len(decoded.results))
+
+	assert.Error(t, qr.Render(&buf, RenderFormat(42)))
+}
+
+func TestFormatValue(t *testing.T) {
+	src := &Node{ID: 1, Labels: []string{"Person"}, Properties: map[string]interface{}{"name": "x"}}
+	dst := &Node{ID: 2, Properties: map[string]interface{}{}}
+	edge := &Edge{ID: 3, Relation: "KNOWS", Source: dst, Destination: src, Properties: map[string]interface{}{}}
+	path := Path{Nodes: []*Node{src, dst}, Edges: []*Edge{edge}}
+
+	assert.Equal(t, "text", formatValue("text"))
+	assert.Equal(t, `["a", 1, 1.5, true, null]`, formatValue([]interface{}{"a", int64(1), 1.5, true, nil}))
+	assert.Equal(t, "{`a b`: 1, c: {d: \"e\"}}", formatValue(map[string]interface{}{
+		"c":   map[string]interface{}{"d": "e"},
+		"a b": int64(1),
+	}))
+	assert.Equal(t, `(1:Person {name: "x"})<-[3:KNOWS]-(2)`, formatValue(path))
+	assert.Equal(t, "vecf32([1,2.5])", formatValue(Vector32{1, 2.5}))
+}
@@ -0,0 +1,71 @@
+package falkordb
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Checked accessors for the elements of a raw reply.
+// A reply of an unexpected shape results in an ErrMalformedReply error
+// describing the offending element rather than a panic.
+
+func malformed(what string, v interface{}) error {
+	return fmt.Errorf("%w: expected %s, got %v (%T)", ErrMalformedReply, what, v, v)
+}
+
+func replyArray(v interface{}, what string) ([]interface{}, error) {
+	a, ok := v.([]interface{})
+	if !ok {
+		return nil, malformed(what, v)
+	}
+	return a, nil
+}
+
+// replyTuple returns v as an array of exactly n elements.
+func replyTuple(v interface{}, n int, what string) ([]interface{}, error) {
+	a, ok := v.([]interface{})
+	if !ok || len(a) != n {
+		return nil, malformed(fmt.Sprintf("%s of %d elements", what, n), v)
+	}
+	return a, nil
+}
+
+func replyInt(v interface{}, what string) (int64, error) {
+	i, ok := v.(int64)
+	if !ok {
+		return 0, malformed(what, v)
+	}
+	return i, nil
+}
+
+// replyID returns v as a non-negative entity ID.
+func replyID(v interface{}, what string) (uint64, error) {
+	i, ok := v.(int64)
+	if !ok || i < 0 {
+		return 0, malformed(what, v)
+	}
+	return uint64(i), nil
+}
+
+func replyString(v interface{}, what string) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", malformed(what, v)
+	}
+	return s, nil
+}
+
+// doubles are reported as strings, accept native floats as well.
+func replyDouble(v interface{}, what string) (float64, error) {
+	switch f := v.(type) {
+	case string:
+		d, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s: %v", ErrMalformedReply, what, err)
+		}
+		return d, nil
+	case float64:
+		return f, nil
+	}
+	return 0, malformed(what, v)
+}
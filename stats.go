@@ -0,0 +1,76 @@
+package falkordb
+
+// Stats holds the statistics reported for the execution of a query.
+type Stats struct {
+	LabelsAdded          int
+	LabelsRemoved        int
+	NodesCreated         int
+	NodesDeleted         int
+	PropertiesSet        int
+	PropertiesRemoved    int
+	RelationshipsCreated int
+	RelationshipsDeleted int
+	IndicesCreated       int
+	IndicesDeleted       int
+	ConstraintsCreated   int
+	ConstraintsDeleted   int
+	// CachedExecution counts the executions which used a cached execution plan.
+	CachedExecution int
+	// InternalExecutionTime is the server side execution time in milliseconds.
+	InternalExecutionTime float64
+	// GraphRemovedInternalExecutionTime is the time in milliseconds it took to remove the graph,
+	// only reported by Graph.DeleteWithStats.
+	GraphRemovedInternalExecutionTime float64
+}
+
+func statsFromMap(statistics map[string]float64) Stats {
+	return Stats{
+		LabelsAdded:                       int(statistics[LABELS_ADDED]),
+		LabelsRemoved:                     int(statistics[LABELS_REMOVED]),
+		NodesCreated:                      int(statistics[NODES_CREATED]),
+		NodesDeleted:                      int(statistics[NODES_DELETED]),
+		PropertiesSet:                     int(statistics[PROPERTIES_SET]),
+		PropertiesRemoved:                 int(statistics[PROPERTIES_REMOVED]),
+		RelationshipsCreated:              int(statistics[RELATIONSHIPS_CREATED]),
+		RelationshipsDeleted:              int(statistics[RELATIONSHIPS_DELETED]),
+		IndicesCreated:                    int(statistics[INDICES_CREATED]),
+		IndicesDeleted:                    int(statistics[INDICES_DELETED]),
+		ConstraintsCreated:                int(statistics[CONSTRAINTS_CREATED]),
+		ConstraintsDeleted:                int(statistics[CONSTRAINTS_DELETED]),
+		CachedExecution:                   int(statistics[CACHED_EXECUTION]),
+		InternalExecutionTime:             statistics[INTERNAL_EXECUTION_TIME],
+		GraphRemovedInternalExecutionTime: statistics[GRAPH_REMOVED_INTERNAL_EXECUTION_TIME],
+	}
+}
+
+// Add returns the sum of s and other.
+func (s Stats) Add(other Stats) Stats {
+	return Stats{
+		LabelsAdded:                       s.LabelsAdded + other.LabelsAdded,
+		LabelsRemoved:                     s.LabelsRemoved + other.LabelsRemoved,
+		NodesCreated:                      s.NodesCreated + other.NodesCreated,
+		NodesDeleted:                      s.NodesDeleted + other.NodesDeleted,
+		PropertiesSet:                     s.PropertiesSet + other.PropertiesSet,
+		PropertiesRemoved:                 s.PropertiesRemoved + other.PropertiesRemoved,
+		RelationshipsCreated:              s.RelationshipsCreated + other.RelationshipsCreated,
+		RelationshipsDeleted:              s.RelationshipsDeleted + other.RelationshipsDeleted,
+		IndicesCreated:                    s.IndicesCreated + other.IndicesCreated,
+		IndicesDeleted:                    s.IndicesDeleted + other.IndicesDeleted,
+		ConstraintsCreated:                s.ConstraintsCreated + other.ConstraintsCreated,
+		ConstraintsDeleted:                s.ConstraintsDeleted + other.ConstraintsDeleted,
+		CachedExecution:                   s.CachedExecution + other.CachedExecution,
+		InternalExecutionTime:             s.InternalExecutionTime + other.InternalExecutionTime,
+		GraphRemovedInternalExecutionTime: s.GraphRemovedInternalExecutionTime + other.GraphRemovedInternalExecutionTime,
+	}
+}
+
+// SumStats aggregates the statistics of results, nil results are skipped.
+func SumStats(results ...*QueryResult) Stats {
+	var s Stats
+	for _, qr := range results {
+		if qr != nil {
+			s = s.Add(qr.Stats())
+		}
+	}
+	return s
+}
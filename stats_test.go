@@ -0,0 +1,58 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	reply := arr(arr(
+		"Labels added: 1",
+		"Labels removed: 2",
+		"Nodes created: 3",
+		"Properties set: 4",
+		"Properties removed: 5",
+		"Constraints created: 1",
+		"Constraints deleted: 1",
+		"Cached execution: 1",
+		"Query internal execution time: 0.250000 milliseconds",
+	))
+	qr, err := sampleDecoder.Decode(reply)
+	assert.NoError(t, err)
+
+	expected := Stats{
+		LabelsAdded:           1,
+		LabelsRemoved:         2,
+		NodesCreated:          3,
+		PropertiesSet:         4,
+		PropertiesRemoved:     5,
+		ConstraintsCreated:    1,
+		ConstraintsDeleted:    1,
+		CachedExecution:       1,
+		InternalExecutionTime: 0.25,
+	}
+	assert.Equal(t, expected, qr.Stats())
+	assert.Equal(t, 2, qr.LabelsRemoved())
+	assert.Equal(t, 5, qr.PropertiesRemoved())
+	assert.Equal(t, 9, len(qr.Statistics()))
+
+	// Statistics returns a copy
+	qr.Statistics()[NODES_CREATED] = 100
+	assert.Equal(t, 3, qr.NodesCreated())
+
+	sum := SumStats(qr, nil, qr)
+	assert.Equal(t, 6, sum.NodesCreated)
+	assert.Equal(t, 2, sum.CachedExecution)
+	assert.Equal(t, 0.5, sum.InternalExecutionTime)
+	assert.Equal(t, sum, expected.Add(expected))
+}
+
+func TestDeleteStats(t *testing.T) {
+	stats, err := deleteStats("Graph removed, internal execution time: 0.125000 milliseconds")
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{GraphRemovedInternalExecutionTime: 0.125}, stats)
+
+	_, err = deleteStats("OK")
+	assert.ErrorIs(t, err, ErrMalformedReply)
+}
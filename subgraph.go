@@ -0,0 +1,82 @@
+package falkordb
+
+// Subgraph is an in-memory graph of distinct nodes and edges, keyed by ID.
+// The Source and Destination of its edges point to the subgraph's nodes.
+type Subgraph struct {
+	Nodes map[uint64]*Node
+	Edges map[uint64]*Edge
+
+	nodeOrder []*Node
+	edgeOrder []*Edge
+	outgoing  map[uint64][]*Edge
+	incoming  map[uint64][]*Edge
+}
+
+// newSubgraph builds a subgraph out of the entities of s, which must hold
+// the endpoints of all its edges.
+// Edges are copied so the entities they were collected from are left untouched.
+func newSubgraph(s *entitySet) *Subgraph {
+	sg := &Subgraph{
+		Nodes:     make(map[uint64]*Node, len(s.nodes)),
+		Edges:     make(map[uint64]*Edge, len(s.edges)),
+		nodeOrder: s.nodes,
+		edgeOrder: make([]*Edge, len(s.edges)),
+		outgoing:  make(map[uint64][]*Edge),
+		incoming:  make(map[uint64][]*Edge),
+	}
+	for _, n := range s.nodes {
+		sg.Nodes[n.ID] = n
+	}
+	for i, e := range s.edges {
+		wired := *e
+		wired.srcNodeID = e.SourceNodeID()
+		wired.destNodeID = e.DestNodeID()
+		wired.Source = sg.Nodes[wired.srcNodeID]
+		wired.Destination = sg.Nodes[wired.destNodeID]
+
+		sg.Edges[e.ID] = &wired
+		sg.edgeOrder[i] = &wired
+		sg.outgoing[wired.srcNodeID] = append(sg.outgoing[wired.srcNodeID], &wired)
+		sg.incoming[wired.destNodeID] = append(sg.incoming[wired.destNodeID], &wired)
+	}
+	return sg
+}
+
+// Subgraph assembles the distinct nodes and edges held by the result,
+// including those within paths, arrays and maps, into a Subgraph.
+// When an entity is returned several times the first occurrence is kept.
+// Endpoints of the edges which are not part of the result are added as nodes
+// holding only their ID.
+func (qr *QueryResult) Subgraph() *Subgraph {
+	return newSubgraph(resultEntities(qr))
+}
+
+// Node returns the node with the given ID, nil if not part of the subgraph.
+func (sg *Subgraph) Node(id uint64) *Node {
+	return sg.Nodes[id]
+}
+
+// Edge returns the edge with the given ID, nil if not part of the subgraph.
+func (sg *Subgraph) Edge(id uint64) *Edge {
+	return sg.Edges[id]
+}
+
+// NodeList returns the nodes of the subgraph in the order they were added.
+func (sg *Subgraph) NodeList() []*Node {
+	return sg.nodeOrder
+}
+
+// EdgeList returns the edges of the subgraph in the order they were added.
+func (sg *Subgraph) EdgeList() []*Edge {
+	return sg.edgeOrder
+}
+
+// Outgoing returns the edges leaving the node with the given ID.
+func (sg *Subgraph) Outgoing(id uint64) []*Edge {
+	return sg.outgoing[id]
+}
+
+// Incoming returns the edges reaching the node with the given ID.
+func (sg *Subgraph) Incoming(id uint64) []*Edge {
+	return sg.incoming[id]
+}
@@ -0,0 +1,203 @@
+package falkordb
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+// Direction is the direction edges are followed in.
+type Direction int
+
+const (
+	// DIRECTION_OUTGOING follows edges from their source to their destination.
+	DIRECTION_OUTGOING Direction = iota
+	// DIRECTION_INCOMING follows edges from their destination to their source.
+	DIRECTION_INCOMING
+	// DIRECTION_BOTH follows edges either way.
+	DIRECTION_BOTH
+)
+
+// NewSubgraph builds a subgraph out of nodes and edges, keeping the first
+// occurrence of entities given several times.
+// Endpoints of the edges which are not among nodes are added as nodes
+// holding only their ID.
+func NewSubgraph(nodes []*Node, edges []*Edge) *Subgraph {
+	s := newEntitySet()
+	for _, n := range nodes {
+		s.addNode(n)
+	}
+	for _, e := range edges {
+		s.addEdge(e)
+	}
+	s.addMissingEndpoints()
+	return newSubgraph(s)
+}
+
+// step is an edge followed from a node, along with the node it leads to.
+type step struct {
+	edge *Edge
+	node *Node
+}
+
+// steps lists the edges followed from the node with the given ID, in the
+// order they were added, outgoing ones first.
+func (sg *Subgraph) steps(id uint64, direction Direction) []step {
+	var steps []step
+	if direction != DIRECTION_INCOMING {
+		for _, e := range sg.outgoing[id] {
+			steps = append(steps, step{e, e.Destination})
+		}
+	}
+	if direction != DIRECTION_OUTGOING {
+		for _, e := range sg.incoming[id] {
+			steps = append(steps, step{e, e.Source})
+		}
+	}
+	return steps
+}
+
+// BFS returns the nodes reachable from the node with the given ID,
+// itself included, in breadth first order.
+// It returns nil if the node is not part of the subgraph.
+func (sg *Subgraph) BFS(start uint64, direction Direction) []*Node {
+	n, ok := sg.Nodes[start]
+	if !ok {
+		return nil
+	}
+
+	visited := map[uint64]bool{start: true}
+	order := []*Node{n}
+	for i := 0; i < len(order); i++ {
+		for _, s := range sg.steps(order[i].ID, direction) {
+			if !visited[s.node.ID] {
+				visited[s.node.ID] = true
+				order = append(order, s.node)
+			}
+		}
+	}
+	return order
+}
+
+// ShortestPath finds the path of least total weight between two nodes
+// using Dijkstra's algorithm, returning the path along with its weight.
+// weight gives the weight of an edge, which must not be negative;
+// when nil every edge weighs 1. ErrNoPath is returned when dst can't be reached.
+func (sg *Subgraph) ShortestPath(src uint64, dst uint64, direction Direction, weight func(*Edge) float64) (Path, float64, error) {
+	if _, ok := sg.Nodes[src]; !ok {
+		return Path{}, 0, fmt.Errorf("node %d is not part of the subgraph", src)
+	}
+	if _, ok := sg.Nodes[dst]; !ok {
+		return Path{}, 0, fmt.Errorf("node %d is not part of the subgraph", dst)
+	}
+	if weight == nil {
+		weight = func(*Edge) float64 { return 1 }
+	}
+
+	dist := map[uint64]float64{src: 0}
+	via := make(map[uint64]step)
+	done := make(map[uint64]bool)
+	queue := &distanceQueue{{src, 0}}
+	for queue.Len() > 0 {
+		cur := heap.Pop(queue).(distance)
+		if done[cur.id] {
+			continue
+		}
+		done[cur.id] = true
+		if cur.id == dst {
+			break
+		}
+
+		for _, s := range sg.steps(cur.id, direction) {
+			w := weight(s.edge)
+			if w < 0 {
+				return Path{}, 0, fmt.Errorf("edge %d has negative weight %v", s.edge.ID, w)
+			}
+			d := cur.dist + w
+			if prev, ok := dist[s.node.ID]; !ok || d < prev {
+				dist[s.node.ID] = d
+				via[s.node.ID] = step{s.edge, sg.Nodes[cur.id]}
+				heap.Push(queue, distance{s.node.ID, d})
+			}
+		}
+	}
+
+	if !done[dst] {
+		return Path{}, 0, ErrNoPath
+	}
+
+	// walk back from the destination, then reverse
+	p := Path{Nodes: []*Node{sg.Nodes[dst]}}
+	for id := dst; id != src; {
+		s := via[id]
+		p.Nodes = append(p.Nodes, s.node)
+		p.Edges = append(p.Edges, s.edge)
+		id = s.node.ID
+	}
+	for i, j := 0, len(p.Nodes)-1; i < j; i, j = i+1, j-1 {
+		p.Nodes[i], p.Nodes[j] = p.Nodes[j], p.Nodes[i]
+	}
+	for i, j := 0, len(p.Edges)-1; i < j; i, j = i+1, j-1 {
+		p.Edges[i], p.Edges[j] = p.Edges[j], p.Edges[i]
+	}
+	return p, dist[dst], nil
+}
+
+type distance struct {
+	id   uint64
+	dist float64
+}
+
+// distanceQueue is a min-heap of tentative distances.
+type distanceQueue []distance
+
+func (q distanceQueue) Len() int            { return len(q) }
+func (q distanceQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q distanceQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *distanceQueue) Push(x interface{}) { *q = append(*q, x.(distance)) }
+func (q *distanceQueue) Pop() interface{} {
+	old := *q
+	d := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return d
+}
+
+// ConnectedComponents returns the weakly connected components of the
+// subgraph, that is the groups of nodes connected when ignoring the edges
+// direction. Components are ordered by their first node, in the order the
+// nodes were added.
+func (sg *Subgraph) ConnectedComponents() [][]*Node {
+	var components [][]*Node
+	seen := make(map[uint64]bool)
+	for _, n := range sg.nodeOrder {
+		if seen[n.ID] {
+			continue
+		}
+		component := sg.BFS(n.ID, DIRECTION_BOTH)
+		for _, m := range component {
+			seen[m.ID] = true
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// Degree returns the number of edges of the node with the given ID in the
+// given direction. Self loops count twice when both directions are followed.
+func (sg *Subgraph) Degree(id uint64, direction Direction) int {
+	return len(sg.steps(id, direction))
+}
+
+// DegreeCentrality returns the degree of every node in the given direction,
+// divided by the number of other nodes in the subgraph.
+func (sg *Subgraph) DegreeCentrality(direction Direction) map[uint64]float64 {
+	centrality := make(map[uint64]float64, len(sg.Nodes))
+	others := float64(len(sg.Nodes) - 1)
+	for id := range sg.Nodes {
+		if others > 0 {
+			centrality[id] = float64(sg.Degree(id, direction)) / others
+		} else {
+			centrality[id] = 0
+		}
+	}
+	return centrality
+}
@@ -0,0 +1,99 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleSubgraph() *Subgraph {
+	nodes := make([]*Node, 5)
+	for i := range nodes {
+		nodes[i] = &Node{ID: uint64(i + 1), Properties: map[string]interface{}{}}
+	}
+	edge := func(id uint64, src, dst *Node, w float64) *Edge {
+		return &Edge{ID: id, Relation: "R", Source: src, Destination: dst, Properties: map[string]interface{}{"w": w}}
+	}
+	edges := []*Edge{
+		edge(10, nodes[0], nodes[1], 1),
+		edge(11, nodes[1], nodes[2], 1),
+		edge(12, nodes[0], nodes[2], 5),
+		edge(13, nodes[4], nodes[3], 2),
+		edge(10, nodes[0], nodes[1], 1),
+	}
+	return NewSubgraph(append(nodes, nodes[0]), edges)
+}
+
+func ids(nodes []*Node) []uint64 {
+	out := make([]uint64, len(nodes))
+	for i, n := range nodes {
+		out[i] = n.ID
+	}
+	return out
+}
+
+func TestSubgraphTraversal(t *testing.T) {
+	sg := sampleSubgraph()
+	assert.Len(t, sg.Nodes, 5)
+	assert.Len(t, sg.Edges, 4)
+
+	assert.Equal(t, []uint64{1, 2, 3}, ids(sg.BFS(1, DIRECTION_OUTGOING)))
+	assert.Equal(t, []uint64{3}, ids(sg.BFS(3, DIRECTION_OUTGOING)))
+	assert.Equal(t, []uint64{3, 2, 1}, ids(sg.BFS(3, DIRECTION_INCOMING)))
+	assert.Equal(t, []uint64{4, 5}, ids(sg.BFS(4, DIRECTION_BOTH)))
+	assert.Nil(t, sg.BFS(42, DIRECTION_BOTH))
+
+	components := sg.ConnectedComponents()
+	assert.Len(t, components, 2)
+	assert.Equal(t, []uint64{1, 2, 3}, ids(components[0]))
+	assert.Equal(t, []uint64{4, 5}, ids(components[1]))
+
+	// endpoints missing from the nodes are added
+	sg = NewSubgraph(nil, []*Edge{{ID: 1, srcNodeID: 7, destNodeID: 8}})
+	assert.Equal(t, []uint64{7, 8}, ids(sg.BFS(7, DIRECTION_OUTGOING)))
+}
+
+func TestSubgraphShortestPath(t *testing.T) {
+	sg := sampleSubgraph()
+	weight := func(e *Edge) float64 { return e.GetProperty("w").(float64) }
+
+	p, w, err := sg.ShortestPath(1, 3, DIRECTION_OUTGOING, weight)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, w)
+	assert.Equal(t, []uint64{1, 2, 3}, ids(p.Nodes))
+	assert.Equal(t, uint64(10), p.Edges[0].ID)
+	assert.Equal(t, uint64(11), p.Edges[1].ID)
+
+	p, w, err = sg.ShortestPath(1, 3, DIRECTION_OUTGOING, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, w)
+	assert.Equal(t, []uint64{1, 3}, ids(p.Nodes))
+
+	p, w, err = sg.ShortestPath(1, 1, DIRECTION_OUTGOING, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, w)
+	assert.Equal(t, []uint64{1}, ids(p.Nodes))
+
+	_, _, err = sg.ShortestPath(3, 1, DIRECTION_OUTGOING, nil)
+	assert.ErrorIs(t, err, ErrNoPath)
+	_, _, err = sg.ShortestPath(3, 1, DIRECTION_INCOMING, nil)
+	assert.NoError(t, err)
+	_, _, err = sg.ShortestPath(1, 4, DIRECTION_BOTH, nil)
+	assert.ErrorIs(t, err, ErrNoPath)
+	_, _, err = sg.ShortestPath(1, 42, DIRECTION_BOTH, nil)
+	assert.Error(t, err)
+	_, _, err = sg.ShortestPath(1, 3, DIRECTION_OUTGOING, func(*Edge) float64 { return -1 })
+	assert.Error(t, err)
+}
+
+func TestSubgraphDegree(t *testing.T) {
+	sg := sampleSubgraph()
+	assert.Equal(t, 2, sg.Degree(1, DIRECTION_OUTGOING))
+	assert.Equal(t, 0, sg.Degree(1, DIRECTION_INCOMING))
+	assert.Equal(t, 2, sg.Degree(3, DIRECTION_BOTH))
+
+	centrality := sg.DegreeCentrality(DIRECTION_BOTH)
+	assert.Equal(t, 0.5, centrality[1])
+	assert.Equal(t, 0.25, centrality[4])
+	assert.Equal(t, map[uint64]float64{7: 0}, NewSubgraph([]*Node{{ID: 7}}, nil).DegreeCentrality(DIRECTION_BOTH))
+}
@@ -0,0 +1,55 @@
+package falkordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubgraph(t *testing.T) {
+	country := arr(int64(1), arr(int64(1)), arr())
+	path := arr(int64(VALUE_PATH), arr(
+		arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_NODE), sampleNode), arr(int64(VALUE_NODE), country))),
+		arr(int64(VALUE_ARRAY), arr(arr(int64(VALUE_EDGE), sampleEdge))),
+	))
+	reply := arr(
+		arr(arr(int64(COLUMN_SCALAR), "p")),
+		arr(arr(path), arr(path)),
+		sampleStats,
+	)
+	qr, err := sampleDecoder.Decode(reply)
+	assert.NoError(t, err)
+
+	sg := qr.Subgraph()
+	assert.Len(t, sg.Nodes, 2)
+	assert.Len(t, sg.Edges, 1)
+	assert.Equal(t, []uint64{0, 1}, []uint64{sg.NodeList()[0].ID, sg.NodeList()[1].ID})
+
+	e := sg.Edge(1)
+	assert.Same(t, sg.Node(0), e.Source)
+	assert.Same(t, sg.Node(1), e.Destination)
+	assert.Equal(t, []*Edge{e}, sg.Outgoing(0))
+	assert.Equal(t, []*Edge{e}, sg.Incoming(1))
+	assert.Empty(t, sg.Outgoing(1))
+	assert.Nil(t, sg.Node(7))
+
+	// the decoded edges are left untouched
+	qr.Next()
+	p, err := qr.Record().GetByIndex(0)
+	assert.NoError(t, err)
+	assert.Nil(t, p.(Path).Edges[0].Source)
+
+	// endpoints missing from the result are added as bare nodes
+	reply = arr(arr(arr(int64(COLUMN_SCALAR), "e")), arr(arr(arr(int64(VALUE_EDGE), sampleEdge))), sampleStats)
+	qr, err = sampleDecoder.Decode(reply)
+	assert.NoError(t, err)
+	sg = qr.Subgraph()
+	assert.Len(t, sg.Nodes, 2)
+	assert.Equal(t, uint64(1), sg.Edge(1).Destination.ID)
+}
+
+func TestEdgeEndpointIDs(t *testing.T) {
+	e := &Edge{Destination: &Node{ID: 4}, srcNodeID: 2}
+	assert.Equal(t, uint64(2), e.SourceNodeID())
+	assert.Equal(t, uint64(4), e.DestNodeID())
+}
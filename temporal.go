@@ -0,0 +1,86 @@
+package falkordb
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Date represents a calendar date without a time of day or time zone,
+// as produced by the date() function.
+//
+// Temporal values are stored by FalkorDB with a resolution of one second and
+// reported as seconds since the unix epoch, so the sub-second components of
+// the time.Time, LocalTime and time.Duration parameters are truncated.
+// A time.Time parameter is sent as a localdatetime, which carries no time
+// zone, holding its UTC wall clock: it is read back as a UTC time.Time of
+// the same instant, truncated to the second.
+type Date struct {
+	time.Time
+}
+
+// DateOf returns the calendar date of t.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+}
+
+// Returns the date formatted as YYYY-MM-DD
+func (d Date) String() string {
+	return d.Format(time.DateOnly)
+}
+
+// LocalTime represents a time of day without a date or time zone,
+// as produced by the localtime() function.
+// It is encoded with a resolution of one second.
+type LocalTime struct {
+	time.Time
+}
+
+// LocalTimeOf returns the time of day of t.
+func LocalTimeOf(t time.Time) LocalTime {
+	return LocalTime{time.Date(1970, time.January, 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)}
+}
+
+// Returns the time of day formatted as HH:MM:SS
+func (t LocalTime) String() string {
+	return t.Format(time.TimeOnly)
+}
+
+// temporal values are reported as seconds since the unix epoch,
+// durations as a number of seconds.
+func temporalSeconds(v interface{}) (int64, error) {
+	switch s := v.(type) {
+	case int64:
+		return s, nil
+	case string:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: temporal value: %v", ErrMalformedReply, err)
+		}
+		return i, nil
+	}
+	return 0, malformed("temporal value", v)
+}
+
+// timeToString encodes t as a localdatetime holding its UTC wall clock,
+// truncated to the second.
+func timeToString(t time.Time) string {
+	t = t.UTC()
+	return fmt.Sprintf("localdatetime({year: %d, month: %d, day: %d, hour: %d, minute: %d, second: %d})",
+		t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second())
+}
+
+func dateToString(d Date) string {
+	return fmt.Sprintf("date({year: %d, month: %d, day: %d})", d.Year(), int(d.Month()), d.Day())
+}
+
+func localTimeToString(t LocalTime) string {
+	return fmt.Sprintf("localtime({hour: %d, minute: %d, second: %d})", t.Hour(), t.Minute(), t.Second())
+}
+
+// durationToString encodes d truncated to the second, towards zero,
+// as durations are stored with a resolution of one second.
+func durationToString(d time.Duration) string {
+	return fmt.Sprintf("duration({seconds: %d})", int64(d/time.Second))
+}
@@ -0,0 +1,26 @@
+package falkordb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemporalEncoding(t *testing.T) {
+	// sub-second components are truncated, times are sent as their UTC wall clock
+	paris := time.FixedZone("CET", 3600)
+	ts := time.Date(2024, time.March, 15, 11, 20, 30, 999999999, paris)
+	assert.Equal(t, "localdatetime({year: 2024, month: 3, day: 15, hour: 10, minute: 20, second: 30})", ToString(ts))
+	assert.Equal(t, "localtime({hour: 10, minute: 20, second: 30})", ToString(LocalTimeOf(ts.UTC())))
+	assert.Equal(t, "duration({seconds: 90})", ToString(90*time.Second+999*time.Millisecond))
+	assert.Equal(t, "duration({seconds: -1})", ToString(-1500*time.Millisecond))
+
+	// the decoded value is the same instant, in UTC
+	qr, err := sampleDecoder.Decode(sampleReply(arr(int64(VALUE_DATETIME), ts.Unix())))
+	assert.NoError(t, err)
+	qr.Next()
+	v, err := qr.Record().GetByIndex(0)
+	assert.NoError(t, err)
+	assert.Equal(t, ts.Truncate(time.Second).UTC(), v)
+}
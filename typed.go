@@ -0,0 +1,356 @@
+package falkordb
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// QueryScalar executes query and returns the first column of its only record
+// converted to T.
+// ErrNoRows is returned if the query produced no records,
+// ErrMultipleRows if it produced more than one.
+func QueryScalar[T any](g *Graph, query string, params map[string]interface{}, options *QueryOptions) (T, error) {
+	return scalarOf[T](g.Query(query, params, options))
+}
+
+// ROQueryScalar executes a read only query, see QueryScalar.
+func ROQueryScalar[T any](g *Graph, query string, params map[string]interface{}, options *QueryOptions) (T, error) {
+	return scalarOf[T](g.ROQuery(query, params, options))
+}
+
+// QueryOne executes query and decodes its only record into T.
+// Struct types are populated column by column, see QueryStructs.
+// ErrNoRows is returned if the query produced no records,
+// ErrMultipleRows if it produced more than one.
+func QueryOne[T any](g *Graph, query string, params map[string]interface{}, options *QueryOptions) (T, error) {
+	return oneOf[T](g.Query(query, params, options))
+}
+
+// ROQueryOne executes a read only query, see QueryOne.
+func ROQueryOne[T any](g *Graph, query string, params map[string]interface{}, options *QueryOptions) (T, error) {
+	return oneOf[T](g.ROQuery(query, params, options))
+}
+
+// QueryColumn executes query and returns the first column of every record
+// converted to T.
+func QueryColumn[T any](g *Graph, query string, params map[string]interface{}, options *QueryOptions) ([]T, error) {
+	return columnOf[T](g.Query(query, params, options))
+}
+
+// ROQueryColumn executes a read only query, see QueryColumn.
+func ROQueryColumn[T any](g *Graph, query string, params map[string]interface{}, options *QueryOptions) ([]T, error) {
+	return columnOf[T](g.ROQuery(query, params, options))
+}
+
+// QueryStructs executes query and decodes every record into T.
+//
+// Columns are matched against struct fields by the `falkordb` field tag,
+// falling back to a case-insensitive match of the field name; a tag of "-"
+// skips the field. A record made of a single node, edge or map column which
+// matches no field is decoded from its properties instead.
+func QueryStructs[T any](g *Graph, query string, params map[string]interface{}, options *QueryOptions) ([]T, error) {
+	return structsOf[T](g.Query(query, params, options))
+}
+
+// ROQueryStructs executes a read only query, see QueryStructs.
+func ROQueryStructs[T any](g *Graph, query string, params map[string]interface{}, options *QueryOptions) ([]T, error) {
+	return structsOf[T](g.ROQuery(query, params, options))
+}
+
+func scalarOf[T any](qr *QueryResult, err error) (T, error) {
+	var out T
+	r, err := singleRecord(qr, err)
+	if err != nil {
+		return out, err
+	}
+	if len(r.values) == 0 {
+		return out, fmt.Errorf("query returned no columns")
+	}
+	err = assignValue(reflect.ValueOf(&out).Elem(), r.values[0])
+	if err != nil {
+		return out, fmt.Errorf("column %q: %w", r.keys[0], err)
+	}
+	return out, nil
+}
+
+func oneOf[T any](qr *QueryResult, err error) (T, error) {
+	var out T
+	r, err := singleRecord(qr, err)
+	if err != nil {
+		return out, err
+	}
+	err = decodeRecord(reflect.ValueOf(&out).Elem(), r)
+	return out, err
+}
+
+func columnOf[T any](qr *QueryResult, err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]T, len(qr.results))
+	for i, r := range qr.results {
+		if len(r.values) == 0 {
+			return nil, fmt.Errorf("query returned no columns")
+		}
+		err = assignValue(reflect.ValueOf(&out[i]).Elem(), r.values[0])
+		if err != nil {
+			return nil, fmt.Errorf("record %d, column %q: %w", i, r.keys[0], err)
+		}
+	}
+	return out, nil
+}
+
+func structsOf[T any](qr *QueryResult, err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]T, len(qr.results))
+	for i, r := range qr.results {
+		err = decodeRecord(reflect.ValueOf(&out[i]).Elem(), r)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+// singleRecord returns the only record of the result of a query which
+// returned qr or failed with err.
+func singleRecord(qr *QueryResult, err error) (*Record, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(qr.results) {
+	case 0:
+		return nil, ErrNoRows
+	case 1:
+		return qr.results[0], nil
+	}
+	return nil, ErrMultipleRows
+}
+
+// decodeRecord populates dst from the columns of r.
+func decodeRecord(dst reflect.Value, r *Record) error {
+	target := dst
+	for target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		target = target.Elem()
+	}
+
+	if isStructTarget(target.Type()) {
+		fields := structFields(target.Type())
+		if len(r.keys) != 1 || fields[strings.ToLower(r.keys[0])] != nil {
+			for i, key := range r.keys {
+				f := fields[strings.ToLower(key)]
+				if f == nil {
+					continue
+				}
+				err := assignValue(target.FieldByIndex(f), r.values[i])
+				if err != nil {
+					return fmt.Errorf("column %q: %w", key, err)
+				}
+			}
+			return nil
+		}
+	}
+
+	if len(r.values) != 1 {
+		return fmt.Errorf("cannot decode %d columns into %s", len(r.values), dst.Type())
+	}
+	err := assignValue(dst, r.values[0])
+	if err != nil {
+		return fmt.Errorf("column %q: %w", r.keys[0], err)
+	}
+	return nil
+}
+
+var specialStructTypes = map[reflect.Type]bool{
+	reflect.TypeOf(Node{}):      true,
+	reflect.TypeOf(Edge{}):      true,
+	reflect.TypeOf(Path{}):      true,
+	reflect.TypeOf(Point{}):     true,
+	reflect.TypeOf(Date{}):      true,
+	reflect.TypeOf(LocalTime{}): true,
+}
+
+// isStructTarget reports whether values are decoded into t field by field.
+func isStructTarget(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !specialStructTypes[t] && t.PkgPath() != "time"
+}
+
+// structFields maps lower cased column names to the index of the field they decode into.
+func structFields(t reflect.Type) map[string][]int {
+	fields := make(map[string][]int)
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() || f.Anonymous || promotedThroughPointer(t, f.Index) {
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("falkordb"); ok {
+			tag, _, _ = strings.Cut(tag, ",")
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		fields[strings.ToLower(name)] = f.Index
+	}
+	return fields
+}
+
+// fields promoted through an embedded pointer can't be set without allocating it.
+func promotedThroughPointer(t reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		t = t.Field(i).Type
+		if t.Kind() == reflect.Pointer {
+			return true
+		}
+	}
+	return false
+}
+
+// assignValue stores the decoded value src into dst, converting between
+// compatible types.
+func assignValue(dst reflect.Value, src interface{}) error {
+	if src == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	sv := reflect.ValueOf(src)
+	if sv.Type().AssignableTo(dst.Type()) {
+		dst.Set(sv)
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Pointer:
+		v := reflect.New(dst.Type().Elem())
+		err := assignValue(v.Elem(), src)
+		if err != nil {
+			return err
+		}
+		dst.Set(v)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch s := src.(type) {
+		case int64:
+			if dst.OverflowInt(s) {
+				return fmt.Errorf("value %d overflows %s", s, dst.Type())
+			}
+			dst.SetInt(s)
+			return nil
+		case float64:
+			if s != math.Trunc(s) || dst.OverflowInt(int64(s)) {
+				return fmt.Errorf("cannot convert %v to %s", s, dst.Type())
+			}
+			dst.SetInt(int64(s))
+			return nil
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if s, ok := src.(int64); ok {
+			if s < 0 || dst.OverflowUint(uint64(s)) {
+				return fmt.Errorf("value %d overflows %s", s, dst.Type())
+			}
+			dst.SetUint(uint64(s))
+			return nil
+		}
+
+	case reflect.Float32, reflect.Float64:
+		switch s := src.(type) {
+		case int64:
+			dst.SetFloat(float64(s))
+			return nil
+		case float64:
+			dst.SetFloat(s)
+			return nil
+		case float32:
+			dst.SetFloat(float64(s))
+			return nil
+		}
+
+	case reflect.String:
+		if s, ok := src.(string); ok {
+			dst.SetString(s)
+			return nil
+		}
+
+	case reflect.Bool:
+		if s, ok := src.(bool); ok {
+			dst.SetBool(s)
+			return nil
+		}
+
+	case reflect.Slice:
+		if sv.Kind() == reflect.Slice {
+			out := reflect.MakeSlice(dst.Type(), sv.Len(), sv.Len())
+			for i := 0; i < sv.Len(); i++ {
+				err := assignValue(out.Index(i), sv.Index(i).Interface())
+				if err != nil {
+					return fmt.Errorf("element %d: %w", i, err)
+				}
+			}
+			dst.Set(out)
+			return nil
+		}
+
+	case reflect.Map:
+		props, ok := entityProperties(src)
+		if ok && dst.Type().Key().Kind() == reflect.String {
+			out := reflect.MakeMapWithSize(dst.Type(), len(props))
+			for k, v := range props {
+				ev := reflect.New(dst.Type().Elem()).Elem()
+				err := assignValue(ev, v)
+				if err != nil {
+					return fmt.Errorf("key %q: %w", k, err)
+				}
+				out.SetMapIndex(reflect.ValueOf(k).Convert(dst.Type().Key()), ev)
+			}
+			dst.Set(out)
+			return nil
+		}
+
+	case reflect.Struct:
+		props, ok := entityProperties(src)
+		if ok && isStructTarget(dst.Type()) {
+			fields := structFields(dst.Type())
+			for k, v := range props {
+				f := fields[strings.ToLower(k)]
+				if f == nil {
+					continue
+				}
+				err := assignValue(dst.FieldByIndex(f), v)
+				if err != nil {
+					return fmt.Errorf("field %q: %w", k, err)
+				}
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot convert %T to %s", src, dst.Type())
+}
+
+// entityProperties returns the key-value pairs held by maps, nodes and edges.
+func entityProperties(src interface{}) (map[string]interface{}, bool) {
+	switch s := src.(type) {
+	case map[string]interface{}:
+		return s, true
+	case *Node:
+		return s.Properties, true
+	case *Edge:
+		return s.Properties, true
+	}
+	return nil, false
+}
@@ -0,0 +1,88 @@
+package falkordb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type person struct {
+	Name     string
+	Years    int `falkordb:"age"`
+	Tags     []string
+	Ignored  string `falkordb:"-"`
+	Nickname *string
+}
+
+func TestDecodeRecord_Columns(t *testing.T) {
+	r := recordNew([]interface{}{"John", int64(33), []interface{}{"a", "b"}, "x", nil}, []string{"name", "age", "tags", "ignored", "nickname"})
+
+	var p person
+	err := decodeRecord(reflect.ValueOf(&p).Elem(), r)
+	assert.NoError(t, err)
+	assert.Equal(t, person{Name: "John", Years: 33, Tags: []string{"a", "b"}}, p)
+}
+
+func TestDecodeRecord_SingleEntity(t *testing.T) {
+	n := NodeNew([]string{"Person"}, "", map[string]interface{}{"name": "Jane", "age": int64(28)})
+	r := recordNew([]interface{}{n}, []string{"p"})
+
+	var p *person
+	err := decodeRecord(reflect.ValueOf(&p).Elem(), r)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane", p.Name)
+	assert.Equal(t, 28, p.Years)
+
+	var m map[string]interface{}
+	err = decodeRecord(reflect.ValueOf(&m).Elem(), r)
+	assert.NoError(t, err)
+	assert.Equal(t, n.Properties, m)
+}
+
+func TestAssignValue(t *testing.T) {
+	var i8 int8
+	assert.NoError(t, assignValue(reflect.ValueOf(&i8).Elem(), int64(12)))
+	assert.Equal(t, int8(12), i8)
+	assert.Error(t, assignValue(reflect.ValueOf(&i8).Elem(), int64(1000)))
+
+	var u uint
+	assert.Error(t, assignValue(reflect.ValueOf(&u).Elem(), int64(-1)))
+
+	var f float64
+	assert.NoError(t, assignValue(reflect.ValueOf(&f).Elem(), int64(3)))
+	assert.Equal(t, 3.0, f)
+
+	var s string
+	assert.Error(t, assignValue(reflect.ValueOf(&s).Elem(), int64(3)))
+
+	var floats []float64
+	assert.NoError(t, assignValue(reflect.ValueOf(&floats).Elem(), []float32{1, 2}))
+	assert.Equal(t, []float64{1, 2}, floats)
+
+	var v interface{}
+	assert.NoError(t, assignValue(reflect.ValueOf(&v).Elem(), Point{Latitude: 1, Longitude: 2}))
+	assert.Equal(t, Point{Latitude: 1, Longitude: 2}, v)
+}
+
+func TestTypedResults(t *testing.T) {
+	qr := &QueryResult{results: []*Record{
+		recordNew([]interface{}{int64(1)}, []string{"x"}),
+		recordNew([]interface{}{int64(2)}, []string{"x"}),
+	}}
+	xs, err := columnOf[int](qr, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, xs)
+	_, err = scalarOf[int](qr, nil)
+	assert.ErrorIs(t, err, ErrMultipleRows)
+	_, err = oneOf[int](&QueryResult{}, nil)
+	assert.ErrorIs(t, err, ErrNoRows)
+
+	x, err := scalarOf[int](&QueryResult{results: qr.results[1:]}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, x)
+
+	// query errors, such as parameters which can't be encoded, are returned as is
+	_, err = ROQueryStructs[person](&Graph{Id: "detached"}, "RETURN $x", map[string]interface{}{"x": struct{}{}}, nil)
+	assert.EqualError(t, err, `parameter "x": unsupported parameter type struct {}`)
+}
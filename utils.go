@@ -3,69 +3,197 @@ package falkordb
 import (
 	"crypto/rand"
 	"fmt"
+	"math"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/FalkorDB/falkordb-go/v2/internal/cypher"
 )
 
 // go array to string is [1 2 3] for [1, 2, 3] array
 // cypher expects comma separated array
-func arrayToString(arr []interface{}) string {
-	var arrayLength = len(arr)
-	strArray := []string{}
-	for i := 0; i < arrayLength; i++ {
-		strArray = append(strArray, ToString(arr[i]))
+func arrayToString(arr []interface{}) (string, error) {
+	strArray := make([]string, len(arr))
+	for i, e := range arr {
+		s, err := toStringErr(e)
+		if err != nil {
+			return "", err
+		}
+		strArray[i] = s
 	}
-	return "[" + strings.Join(strArray, ",") + "]"
+	return "[" + strings.Join(strArray, ",") + "]", nil
 }
 
-// go array to string is [1 2 3] for [1, 2, 3] array
-// cypher expects comma separated array
 func strArrayToString(arr []string) string {
-	var arrayLength = len(arr)
-	strArray := []string{}
-	for i := 0; i < arrayLength; i++ {
-		strArray = append(strArray, ToString(arr[i]))
+	strArray := make([]string, len(arr))
+	for i, s := range arr {
+		strArray[i] = strconv.Quote(s)
 	}
 	return "[" + strings.Join(strArray, ",") + "]"
 }
 
-func mapToString(data map[string]interface{}) string {
+func mapToString(data map[string]interface{}) (string, error) {
 	pairsArray := []string{}
 	for k, v := range data {
-		pairsArray = append(pairsArray, k+": "+ToString(v))
+		s, err := toStringErr(v)
+		if err != nil {
+			return "", fmt.Errorf("key %q: %w", k, err)
+		}
+		if !isIdentifier(k) {
+			k = quoteIdentifier(k)
+		}
+		pairsArray = append(pairsArray, k+": "+s)
+	}
+	return "{" + strings.Join(pairsArray, ",") + "}", nil
+}
+
+// isIdentifier reports whether name can be used in a query without quoting.
+func isIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		if c != '_' && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && (i == 0 || !('0' <= c && c <= '9')) {
+			return false
+		}
 	}
-	return "{" + strings.Join(pairsArray, ",") + "}"
+	return true
+}
+
+// quoteIdentifier escapes name for use as a label, relationship type
+// or attribute name within a query.
+func quoteIdentifier(name string) string {
+	return cypher.QuoteIdentifier(name)
 }
 
+// ToString encodes i as a Cypher literal, panicking on unsupported types.
+// Integers, floats, booleans and strings of any kind, pointers to them, and
+// slices, arrays and string keyed maps of supported values are supported,
+// unsigned integers above math.MaxInt64 and NaN or infinite floats excepted.
+// time.Time values are encoded as a localdatetime in UTC, and along with
+// LocalTime and time.Duration values truncated to the second, see Date.
 func ToString(i interface{}) string {
+	s, err := toStringErr(i)
+	if err != nil {
+		panic("Unrecognized type to convert to string")
+	}
+	return s
+}
+
+// toStringErr is ToString returning an error on unsupported types.
+// Besides the types handled explicitly, integers, floats, booleans and
+// strings of any kind, pointers to them, and slices, arrays and string keyed
+// maps of supported values are encoded through reflection.
+func toStringErr(i interface{}) (string, error) {
 	if i == nil {
-		return "null"
+		return "null", nil
 	}
 
-	switch i.(type) {
+	switch v := i.(type) {
 	case string:
-		s := i.(string)
-		return strconv.Quote(s)
+		return strconv.Quote(v), nil
 	case int:
-		return strconv.Itoa(i.(int))
+		return strconv.Itoa(v), nil
 	case int64:
-		return strconv.FormatInt(i.(int64), 10)
+		return strconv.FormatInt(v, 10), nil
 	case float64:
-		return strconv.FormatFloat(i.(float64), 'f', -1, 64)
+		return floatToString(v, 64)
 	case bool:
-		return strconv.FormatBool(i.(bool))
+		return strconv.FormatBool(v), nil
 	case []interface{}:
-		arr := i.([]interface{})
-		return arrayToString(arr)
+		return arrayToString(v)
 	case map[string]interface{}:
-		data := i.(map[string]interface{})
-		return mapToString(data)
+		return mapToString(v)
 	case []string:
-		arr := i.([]string)
-		return strArrayToString(arr)
-	default:
-		panic("Unrecognized type to convert to string")
+		return strArrayToString(v), nil
+	case []float32:
+		return vectorToString(v)
+	case Vector32:
+		return vectorToString(v)
+	case Point:
+		return pointToString(v)
+	case time.Time:
+		return timeToString(v), nil
+	case Date:
+		return dateToString(v), nil
+	case LocalTime:
+		return localTimeToString(v), nil
+	case time.Duration:
+		return durationToString(v), nil
+	}
+	return reflectToString(reflect.ValueOf(i))
+}
+
+func reflectToString(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt64 {
+			return "", fmt.Errorf("integer %d overflows a 64 bit signed integer", v.Uint())
+		}
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return floatToString(v.Float(), 32)
+	case reflect.Float64:
+		return floatToString(v.Float(), 64)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.String:
+		return strconv.Quote(v.String()), nil
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return "null", nil
+		}
+		return toStringErr(v.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return "null", nil
+		}
+		arr := make([]interface{}, v.Len())
+		for i := range arr {
+			arr[i] = v.Index(i).Interface()
+		}
+		return arrayToString(arr)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if v.IsNil() {
+			return "null", nil
+		}
+		data := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			data[iter.Key().String()] = iter.Value().Interface()
+		}
+		return mapToString(data)
+	}
+	return "", fmt.Errorf("unsupported parameter type %s", v.Type())
+}
+
+// floatToString formats f, a float of bitSize bits, as a float literal.
+// Integral floats keep a decimal point so they aren't read back as integers.
+func floatToString(f float64, bitSize int) (string, error) {
+	err := checkFinite(f)
+	if err != nil {
+		return "", err
 	}
+	s := strconv.FormatFloat(f, 'f', -1, bitSize)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s, nil
+}
+
+// checkFinite rejects NaN and infinite floats, which have no Cypher literal.
+func checkFinite(f float64) error {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return fmt.Errorf("non-finite float %v has no Cypher literal", f)
+	}
+	return nil
 }
 
 // https://medium.com/@kpbird/golang-generate-fixed-size-random-string-dd6dbd5e63c0
@@ -93,10 +221,26 @@ func RandomString(n int) string {
 	return string(output)
 }
 
+// BuildParamsHeader builds the CYPHER header setting params,
+// panicking on parameters of unsupported types.
 func BuildParamsHeader(params map[string]interface{}) string {
+	header, err := buildParamsHeader(params)
+	if err != nil {
+		panic("Unrecognized type to convert to string")
+	}
+	return header
+}
+
+// buildParamsHeader is BuildParamsHeader returning an error on
+// parameters of unsupported types.
+func buildParamsHeader(params map[string]interface{}) (string, error) {
 	header := "CYPHER "
 	for key, value := range params {
-		header += fmt.Sprintf("%s=%v ", key, ToString(value))
+		s, err := toStringErr(value)
+		if err != nil {
+			return "", fmt.Errorf("parameter %q: %w", key, err)
+		}
+		header += fmt.Sprintf("%s=%v ", key, s)
 	}
-	return header
+	return header, nil
 }
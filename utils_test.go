@@ -0,0 +1,60 @@
+package falkordb
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToStringKinds(t *testing.T) {
+	type celsius float32
+	type name string
+	one := 1
+	for _, c := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{int8(-3), "-3"},
+		{int32(-3), "-3"},
+		{uint8(200), "200"},
+		{uint64(7), "7"},
+		{float32(1.5), "1.5"},
+		{float32(2), "2.0"},
+		{celsius(0.25), "0.25"},
+		{name("x"), `"x"`},
+		{&one, "1"},
+		{(*int)(nil), "null"},
+		{[]int(nil), "null"},
+		{[]int{1, 2}, "[1,2]"},
+		{[2]bool{true, false}, "[true,false]"},
+		{map[string]int{"a": 1}, "{a: 1}"},
+		{map[string]interface{}{"first name": "a"}, "{`first name`: \"a\"}"},
+		{[]map[string]interface{}{{"b": "x"}}, `[{b: "x"}]`},
+	} {
+		assert.Equal(t, c.expected, ToString(c.value), "%T", c.value)
+	}
+	assert.PanicsWithValue(t, "Unrecognized type to convert to string", func() { ToString(struct{}{}) })
+	assert.PanicsWithValue(t, "Unrecognized type to convert to string", func() { ToString(uint64(1 << 63)) })
+}
+
+func TestToStringErr(t *testing.T) {
+	for _, c := range []struct {
+		value interface{}
+		err   string
+	}{
+		{uint64(1 << 63), "integer 9223372036854775808 overflows a 64 bit signed integer"},
+		{struct{}{}, "unsupported parameter type struct {}"},
+		{map[int]int{1: 1}, "unsupported parameter type map[int]int"},
+		{[]interface{}{make(chan int)}, "unsupported parameter type chan int"},
+		{map[string]interface{}{"k": func() {}}, `key "k": unsupported parameter type func()`},
+		{math.NaN(), "non-finite float NaN has no Cypher literal"},
+		{[]interface{}{math.Inf(-1)}, "non-finite float -Inf has no Cypher literal"},
+		{float32(math.Inf(1)), "non-finite float +Inf has no Cypher literal"},
+		{Vector32{1, float32(math.NaN())}, "non-finite float NaN has no Cypher literal"},
+		{Point{Latitude: math.NaN()}, "point coordinates NaN, 0 aren't finite"},
+	} {
+		_, err := toStringErr(c.value)
+		assert.EqualError(t, err, c.err, "%T", c.value)
+	}
+}
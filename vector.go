@@ -0,0 +1,29 @@
+package falkordb
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Vector32 is a vector of 32 bit floats, as produced by the vecf32() function.
+type Vector32 []float32
+
+// vectorToString encodes vec as a vecf32 parameter, rejecting non-finite elements.
+func vectorToString(vec []float32) (string, error) {
+	for _, f := range vec {
+		err := checkFinite(float64(f))
+		if err != nil {
+			return "", err
+		}
+	}
+	return vectorLiteral(vec), nil
+}
+
+// vectorLiteral formats vec as a vecf32 call.
+func vectorLiteral(vec []float32) string {
+	strArray := make([]string, len(vec))
+	for i, f := range vec {
+		strArray[i] = strconv.FormatFloat(float64(f), 'f', -1, 32)
+	}
+	return "vecf32([" + strings.Join(strArray, ",") + "])"
+}